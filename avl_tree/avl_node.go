@@ -33,41 +33,44 @@ func (n *node[T]) insert(value T) *node[T] {
 	return n.balanceNode()
 }
 
-// remove removes a node from the tree, recursively. Returns the new node to be assigned for the parent node
-func (n *node[T]) remove(value T) *node[T] {
+// remove removes a node from the tree, recursively. Returns the new node to be assigned for the parent node, and whether a node was removed
+func (n *node[T]) remove(value T) (*node[T], bool) {
 	if n == nil {
-		return nil
+		return nil, false
 	}
 
 	if value == n.value {
 		// if node has no children
 		if n.left == nil && n.right == nil {
-			return nil
+			return nil, true
 		}
 
 		if n.left == nil {
-			return n.right
+			return n.right, true
 		}
 
 		if n.right == nil {
-			return n.left
+			return n.left, true
 		}
 
 		smallest := findSmallest(n.right)
 		// Reassign current node to the smallest from the right subtree
 		n.value = smallest.value
 		// Remove the copied value from the subtree by recursively calling the algo
-		n.right = n.right.remove(smallest.value)
+		n.right, _ = n.right.remove(smallest.value)
+		// Stop here, so only one copy of a duplicated value is removed
+		return n.balanceNode(), true
 	}
 
+	var removed bool
 	// If value is greater than the current node, recursively call right
 	if value > n.value {
-		n.right = n.right.remove(value)
-		return n.balanceNode()
+		n.right, removed = n.right.remove(value)
+		return n.balanceNode(), removed
 	}
 
-	n.left = n.left.remove(value)
-	return n.balanceNode()
+	n.left, removed = n.left.remove(value)
+	return n.balanceNode(), removed
 }
 
 // balanceNode checks the balance factor of the subtree from itself, and rotates as necessary
@@ -148,11 +151,11 @@ func (n *node[T]) toArray(layer int, array *[][]T) {
 //
 // Big-O: O(log n) because we only have to traverse half the tree.
 func findSmallest[T types](n *node[T]) *node[T] {
-	if n.right == nil {
+	if n.left == nil {
 		return n
 	}
 
-	return findSmallest(n.right)
+	return findSmallest(n.left)
 }
 
 // inOrder recursively retrieves every value in the tree, and returns it in order. (left, root, right)
@@ -34,11 +34,18 @@ func (avl *AVLTree[T]) Insert(value T) {
 }
 
 // Remove removes a node in the tree with the specified value, then balances the tree.
+// Does nothing if the value isn't in the tree.
+//
+// # Returns true if a node was removed, false if the value wasn't in the tree
 //
 // Time complexity: O(log n) because node.remove() is O(log n)
-func (avl *AVLTree[T]) Remove(value T) {
-	avl.Root = avl.Root.remove(value)
-	avl.Count--
+func (avl *AVLTree[T]) Remove(value T) bool {
+	root, removed := avl.Root.remove(value)
+	avl.Root = root
+	if removed {
+		avl.Count--
+	}
+	return removed
 }
 
 // Contains checks if the tree contains a node with the specified value.
@@ -98,6 +105,13 @@ func (avl *AVLTree[T]) ToArray() []T {
 	return array
 }
 
+// ToSortedArray returns the values of the tree as a slice, in order (left, middle, right), so smallest first
+//
+// Time complexity: O(n) because we have to visit every node
+func (avl *AVLTree[T]) ToSortedArray() []T {
+	return avl.Root.inOrder()
+}
+
 // InOrder returns the values of the tree in order (left, middle, right) as a string
 //
 // Time Complexity: O(n)
@@ -105,6 +105,66 @@ func TestAVLTree_InsertChangesCount(t *testing.T) {
 	util.SimpleAssert(t, expected, avl.Count)
 }
 
+func TestAVLTree_RemoveChangesCount(t *testing.T) {
+	// Arrange
+	avl := NewAVLTree[int](10, 5, 15)
+	expected := 2
+	// Act
+	avl.Remove(5)
+	// Assert
+	util.SimpleAssert(t, expected, avl.Count)
+}
+
+func TestAVLTree_RemoveMissingValueKeepsCount(t *testing.T) {
+	// Arrange
+	avl := NewAVLTree[int](10, 5, 15)
+	expected := 3
+	// Act
+	avl.Remove(20)
+	// Assert
+	util.SimpleAssert(t, expected, avl.Count)
+}
+
+func TestAVLTree_RemoveReportsRemoval(t *testing.T) {
+	// Arrange
+	avl := NewAVLTree[int](10, 5, 15)
+	// Act
+	removed := avl.Remove(5)
+	missing := avl.Remove(20)
+	// Assert
+	util.SimpleAssert(t, true, removed)
+	util.SimpleAssert(t, false, missing)
+	util.SimpleAssert(t, false, avl.Contains(5))
+}
+
+func TestAVLTree_RemoveTakesOneDuplicate(t *testing.T) {
+	// Arrange
+	avl := NewAVLTree[int](5, 5, 5)
+	expected := []int{5, 5}
+	// Act
+	removed := avl.Remove(5)
+	// Assert
+	util.SimpleAssert(t, true, removed)
+	actualArray := avl.ToArray()
+	util.SimpleAssert(t, 2, avl.Count)
+	util.SimpleAssert(t, len(expected), len(actualArray))
+	for i := 0; i < len(expected) && i < len(actualArray); i++ {
+		util.SimpleAssert(t, actualArray[i], expected[i])
+	}
+}
+
+func TestAVLTree_RemoveNodeWithTwoChildrenKeepsOrder(t *testing.T) {
+	// Arrange
+	avl := NewAVLTree(10, 5, 15, 12, 20)
+	expected := "5 12 15 20"
+	// Act
+	avl.Remove(10)
+	// Assert
+	util.SimpleAssert(t, avl.InOrder(), expected)
+	util.SimpleAssert(t, avl.Contains(12), true)
+	util.SimpleAssert(t, avl.Contains(15), true)
+}
+
 func TestAVLTree_InsertAddsDuplicate(t *testing.T) {
 	// Arrange
 	avl := NewAVLTree[int](10)
@@ -297,6 +357,19 @@ func TestAVLTree_ToArrayReturnsCorrectArray(t *testing.T) {
 	}
 }
 
+func TestAVLTree_ToSortedArrayReturnsValuesInOrder(t *testing.T) {
+	// Arrange
+	avl := NewAVLTree(10, 15, 5, 3, 6)
+	expectedArray := []int{3, 5, 6, 10, 15}
+	// Act
+	actualArray := avl.ToSortedArray()
+	// Assert
+	util.SimpleAssert(t, len(actualArray), len(expectedArray))
+	for i := 0; i < len(expectedArray) && i < len(actualArray); i++ {
+		util.SimpleAssert(t, actualArray[i], expectedArray[i])
+	}
+}
+
 func TestAVLTree_ToArrayReturnsNilOnEmptyTree(t *testing.T) {
 	// Arrange
 	avl := EmptyAVLTree[int]()
@@ -196,7 +196,9 @@ func (bt *BinaryTree[T]) postOrder(node *node[T]) []T {
 	return append(append(left, right...), node.value)
 }
 
-// Remove removes a node
+// Remove removes a node with the specified value. Does nothing if the value isn't in the tree.
+//
+// # Returns true if a node was removed, false if the value wasn't in the tree
 //
 // Time complexity: O(log n)
 // The time complexity is O(log n) because we typically only traverse half the tree
@@ -209,17 +211,22 @@ func (bt *BinaryTree[T]) postOrder(node *node[T]) []T {
 //	If deleting left/right find largest/smallest (respectively)
 //	replace deleted node with that value
 //	call remove on left/right with value (recurse)
-func (bt *BinaryTree[T]) Remove(value T) {
-	bt.Root = bt.remove(bt.Root, value)
+func (bt *BinaryTree[T]) Remove(value T) bool {
+	root, removed := bt.remove(bt.Root, value)
+	bt.Root = root
+	if removed {
+		bt.Count--
+	}
+	return removed
 }
 
 // Revised Psuedo code:
-// This is a recursive function that returns the new reference to the node
+// This is a recursive function that returns the new reference to the node, and whether a node was removed
 // If a node with no children is getting removed, it will return a nil reference
 // If a node with one child is getting removed, it will return the reference to the child
 // If a node with two children is getting removed, it will find the smallest value in the right subtree, replace the current node with that value, and call remove on the right subtree with that value
 //
-// if node is nil, return nil
+// if node is nil, return nil and false
 // if value = node.value
 //
 //	if node has no children, return nil
@@ -232,43 +239,44 @@ func (bt *BinaryTree[T]) Remove(value T) {
 // Move closer to the value
 // if value > node.value, call remove on right subtree
 // if value < node.value, call remove on left subtree
-func (bt *BinaryTree[T]) remove(node *node[T], value T) *node[T] {
+func (bt *BinaryTree[T]) remove(node *node[T], value T) (*node[T], bool) {
 	// Stop case
 	if node == nil {
-		return nil
+		return nil, false
 	}
 
 	if value == node.value {
 		// If node has no children
 		if node.left == nil && node.right == nil {
-			return nil
+			return nil, true
 		}
 
 		// If node has one child
 		if node.left == nil {
-			return node.right
+			return node.right, true
 		}
 
 		if node.right == nil {
-			return node.left
+			return node.left, true
 		}
 
 		// If node has two children
 		smallestValue := bt.findSmallest(node.right)
 		node.value = smallestValue
-		node.right = bt.remove(node.right, smallestValue)
-		return node
+		node.right, _ = bt.remove(node.right, smallestValue)
+		return node, true
 	}
 
+	var removed bool
 	// If value is greater than current node, go right
 	if value > node.value {
-		node.right = bt.remove(node.right, value)
-		return node
+		node.right, removed = bt.remove(node.right, value)
+		return node, removed
 	}
 
 	// If value is less than current node, go left
-	node.left = bt.remove(node.left, value)
-	return node
+	node.left, removed = bt.remove(node.left, value)
+	return node, removed
 }
 
 // findSmallest finds the smallest value in the tree
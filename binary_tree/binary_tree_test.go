@@ -44,6 +44,43 @@ func TestBTInsertChangesCount(t *testing.T) {
 	util.SimpleAssert(t, expected, bt.Count)
 }
 
+func TestBTRemoveChangesCount(t *testing.T) {
+	// Arrange
+	bt := NewBinaryTree[int](10)
+	bt.Insert(5)
+	bt.Insert(15)
+	expected := 2
+	// Act
+	bt.Remove(5)
+	// Assert
+	util.SimpleAssert(t, expected, bt.Count)
+}
+
+func TestBTRemoveMissingValueKeepsCount(t *testing.T) {
+	// Arrange
+	bt := NewBinaryTree[int](10)
+	bt.Insert(5)
+	expected := 2
+	// Act
+	bt.Remove(20)
+	// Assert
+	util.SimpleAssert(t, expected, bt.Count)
+}
+
+func TestBTRemoveReportsRemoval(t *testing.T) {
+	// Arrange
+	bt := NewBinaryTree[int](10)
+	bt.Insert(5)
+	bt.Insert(15)
+	// Act
+	removed := bt.Remove(10)
+	missing := bt.Remove(20)
+	// Assert
+	util.SimpleAssert(t, true, removed)
+	util.SimpleAssert(t, false, missing)
+	util.SimpleAssert(t, false, bt.Contains(10))
+}
+
 func TestBTInsertAddsNodeRight(t *testing.T) {
 	// Arrange
 	bt := NewBinaryTree[int](10)
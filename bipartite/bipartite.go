@@ -0,0 +1,116 @@
+// Package bipartite checks whether a graph is bipartite, and finds maximum matchings between the two sides.
+//
+// Connections are treated as undirected, so a directed graph is checked as if every arc went both ways.
+package bipartite
+
+import (
+	"sort"
+
+	"github.com/robertjshirts/data-structures/graph"
+	"github.com/robertjshirts/data-structures/queue"
+)
+
+// IsBipartite checks if the vertices of the graph can be split into two sides so that every connection goes between the sides.
+//
+// If the graph is bipartite, ok is true and coloring maps each vertex key to its side, 0 or 1.
+// Otherwise, oddCycle holds the keys of a cycle with an odd number of vertices, which proves the graph isn't bipartite.
+// The last vertex of the cycle is connected to the first.
+//
+// Big-O: O(V log V + E log E) because the keys are sorted so results are the same on every run, and the search itself is O(V + E)
+func IsBipartite(g *graph.Graph) (ok bool, coloring map[string]int, oddCycle []string) {
+	keys, adjacency := undirected(g)
+	coloring = make(map[string]int, len(keys))
+	parent := make(map[string]string, len(keys))
+
+	// Breadth-first search from each uncolored vertex, so every component is covered
+	for _, start := range keys {
+		if _, seen := coloring[start]; seen {
+			continue
+		}
+		coloring[start] = 0
+		parent[start] = start
+
+		q := queue.NewQueue[string]()
+		q.Enqueue(start)
+		for !q.IsEmpty() {
+			current, _ := q.TryDequeue()
+			for _, neighbor := range adjacency[current] {
+				color, seen := coloring[neighbor]
+				if !seen {
+					coloring[neighbor] = 1 - coloring[current]
+					parent[neighbor] = current
+					q.Enqueue(neighbor)
+				} else if color == coloring[current] {
+					return false, nil, cycle(parent, current, neighbor)
+				}
+			}
+		}
+	}
+
+	return true, coloring, nil
+}
+
+// cycle builds the odd cycle made by the breadth-first search tree paths to u and v, plus the connection between them
+//
+// Big-O: O(V) because it walks up the tree from both vertices
+func cycle(parent map[string]string, u, v string) []string {
+	// Mark every ancestor of u, including u
+	ancestors := map[string]bool{u: true}
+	for key := u; parent[key] != key; key = parent[key] {
+		ancestors[parent[key]] = true
+	}
+
+	// Walk up from v until we reach one of them, that's the lowest common ancestor
+	var fromV []string
+	lca := v
+	for !ancestors[lca] {
+		fromV = append(fromV, lca)
+		lca = parent[lca]
+	}
+
+	// u up to the ancestor, then back down to v
+	var result []string
+	for key := u; key != lca; key = parent[key] {
+		result = append(result, key)
+	}
+	result = append(result, lca)
+	for i := len(fromV) - 1; i >= 0; i-- {
+		result = append(result, fromV[i])
+	}
+	return result
+}
+
+// undirected returns the sorted keys of the graph, and the sorted neighbors of each vertex, counting a connection in either direction
+//
+// Big-O: O(V log V + E log E) because everything is sorted
+func undirected(g *graph.Graph) ([]string, map[string][]string) {
+	if g == nil {
+		return nil, nil
+	}
+
+	keys := g.GetKeys()
+	sort.Strings(keys)
+
+	neighbors := make(map[string]map[string]bool, len(keys))
+	for _, key := range keys {
+		neighbors[key] = make(map[string]bool)
+	}
+	for _, key := range keys {
+		for neighbor := range g.Neighbors(key) {
+			neighbors[key][neighbor] = true
+			neighbors[neighbor][key] = true
+		}
+	}
+
+	adjacency := make(map[string][]string, len(keys))
+	for _, key := range keys {
+		list := make([]string, 0, len(neighbors[key]))
+		for neighbor := range neighbors[key] {
+			list = append(list, neighbor)
+		}
+		sort.Strings(list)
+		adjacency[key] = list
+	}
+
+	return keys, adjacency
+}
@@ -0,0 +1,132 @@
+package bipartite
+
+import (
+	"testing"
+
+	"github.com/robertjshirts/data-structures/graph"
+	"github.com/robertjshirts/data-structures/graph_gen"
+)
+
+func TestIsBipartite_EvenCycle(t *testing.T) {
+	// Arrange
+	g := graph.NewGraph([]string{"a,b,c,d", "a,b:1,d:1", "c,b:1,d:1"})
+	// Act
+	ok, coloring, oddCycle := IsBipartite(g)
+	// Assert
+	if !ok {
+		t.Fatalf("Expected a bipartite graph, got odd cycle %v", oddCycle)
+	}
+	assertColoring(t, g, coloring)
+}
+
+func TestIsBipartite_Triangle(t *testing.T) {
+	// Arrange
+	g := graph.NewGraph([]string{"a,b,c", "a,b:1,c:1", "b,c:1"})
+	// Act
+	ok, coloring, oddCycle := IsBipartite(g)
+	// Assert
+	if ok {
+		t.Fatalf("Expected a graph that isn't bipartite, got coloring %v", coloring)
+	}
+	assertOddCycle(t, g, oddCycle)
+}
+
+func TestIsBipartite_OddCycleInSecondComponent(t *testing.T) {
+	// Arrange
+	g := graph.NewGraph([]string{"a,b,c,d,e,f,g", "a,b:1", "c,d:1,g:1", "d,e:1", "e,f:1", "f,g:1"})
+	// Act
+	ok, _, oddCycle := IsBipartite(g)
+	// Assert
+	if ok {
+		t.Fatalf("Expected a graph that isn't bipartite")
+	}
+	if len(oddCycle) != 5 {
+		t.Errorf("Expected a cycle of 5 vertices, got %v", oddCycle)
+	}
+	assertOddCycle(t, g, oddCycle)
+}
+
+func TestIsBipartite_SelfLoop(t *testing.T) {
+	// Arrange
+	g := graph.NewGraph([]string{"a,b", "a,a:1,b:1"})
+	// Act
+	ok, _, oddCycle := IsBipartite(g)
+	// Assert
+	if ok {
+		t.Fatalf("Expected a graph that isn't bipartite")
+	}
+	if len(oddCycle) != 1 || oddCycle[0] != "a" {
+		t.Errorf("Expected cycle [a], got %v", oddCycle)
+	}
+}
+
+func TestIsBipartite_EmptyGraph(t *testing.T) {
+	// Arrange
+	g := graph.EmptyGraph()
+	// Act
+	ok, coloring, _ := IsBipartite(g)
+	// Assert
+	if !ok || len(coloring) != 0 {
+		t.Errorf("Expected an empty bipartite graph, got %v", coloring)
+	}
+}
+
+func TestIsBipartite_RandomGraphs(t *testing.T) {
+	gen := graph_gen.NewGenerator(23, graph_gen.Constant(1))
+	for i := 0; i < 50; i++ {
+		// Arrange
+		g := gen.ErdosRenyi(20, 0.08)
+		// Act
+		ok, coloring, oddCycle := IsBipartite(g)
+		// Assert
+		if ok {
+			assertColoring(t, g, coloring)
+		} else {
+			assertOddCycle(t, g, oddCycle)
+		}
+	}
+}
+
+func TestIsBipartite_TreesAreBipartite(t *testing.T) {
+	// Arrange
+	g := graph_gen.NewGenerator(1, graph_gen.Constant(1)).Tree(200)
+	// Act
+	ok, coloring, _ := IsBipartite(g)
+	// Assert
+	if !ok {
+		t.Fatalf("Expected a bipartite graph")
+	}
+	assertColoring(t, g, coloring)
+}
+
+func assertColoring(t *testing.T, g *graph.Graph, coloring map[string]int) {
+	t.Helper()
+	if len(coloring) != g.Order() {
+		t.Errorf("Expected %v colored vertices, got %v", g.Order(), len(coloring))
+	}
+	for _, key := range g.GetKeys() {
+		for neighbor := range g.Neighbors(key) {
+			if coloring[key] == coloring[neighbor] {
+				t.Errorf("Expected %v and %v to have different colors", key, neighbor)
+			}
+		}
+	}
+}
+
+func assertOddCycle(t *testing.T, g *graph.Graph, cycle []string) {
+	t.Helper()
+	if len(cycle)%2 != 1 {
+		t.Fatalf("Expected an odd cycle, got %v", cycle)
+	}
+	seen := make(map[string]bool)
+	for i, key := range cycle {
+		if seen[key] {
+			t.Errorf("Expected %v to be in the cycle once, got %v", key, cycle)
+		}
+		seen[key] = true
+		next := cycle[(i+1)%len(cycle)]
+		if _, ok := g.Weight(key, next); !ok {
+			t.Errorf("Expected a connection between %v and %v in cycle %v", key, next, cycle)
+		}
+	}
+}
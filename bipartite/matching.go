@@ -0,0 +1,286 @@
+package bipartite
+
+import (
+	"github.com/robertjshirts/data-structures/graph"
+	"github.com/robertjshirts/data-structures/queue"
+)
+
+// sides splits the graph into the given left vertices and every other vertex on the right,
+// and returns the neighbors of each left vertex as indexes into right.
+//
+// Panics if a left vertex doesn't exist, or if a connection doesn't go between the two sides.
+//
+// Big-O: O(V log V + E log E) because of the sorting done by undirected
+func sides(g *graph.Graph, left []string) ([]string, []string, [][]int) {
+	keys, adjacency := undirected(g)
+
+	isLeft := make(map[string]bool, len(left))
+	for _, key := range left {
+		if _, ok := adjacency[key]; !ok {
+			panic("Left vertex doesn't exist")
+		}
+		isLeft[key] = true
+	}
+
+	var leftKeys, rightKeys []string
+	rightIndex := make(map[string]int)
+	for _, key := range keys {
+		if isLeft[key] {
+			leftKeys = append(leftKeys, key)
+		} else {
+			rightIndex[key] = len(rightKeys)
+			rightKeys = append(rightKeys, key)
+		}
+	}
+
+	edges := make([][]int, len(leftKeys))
+	for i, key := range leftKeys {
+		for _, neighbor := range adjacency[key] {
+			if isLeft[neighbor] {
+				panic("Connection between two left vertices")
+			}
+			edges[i] = append(edges[i], rightIndex[neighbor])
+		}
+	}
+	for _, key := range rightKeys {
+		for _, neighbor := range adjacency[key] {
+			if !isLeft[neighbor] {
+				panic("Connection between two right vertices")
+			}
+		}
+	}
+
+	return leftKeys, rightKeys, edges
+}
+
+// HopcroftKarp finds a maximum matching between the left vertices and the rest of the graph, with the Hopcroft-Karp algorithm.
+// Each phase finds a maximal set of shortest augmenting paths at once, instead of one path at a time.
+//
+// Returns a map from each matched left vertex to its right vertex.
+//
+// Panics if a left vertex doesn't exist, or if a connection doesn't go between the left vertices and the rest.
+//
+// Big-O: O(E * sqrt(V)) because there are at most O(sqrt(V)) phases, and each phase is O(E)
+func HopcroftKarp(g *graph.Graph, left []string) map[string]string {
+	leftKeys, rightKeys, edges := sides(g, left)
+
+	// -1 means unmatched
+	matchLeft := make([]int, len(leftKeys))
+	matchRight := make([]int, len(rightKeys))
+	for i := range matchLeft {
+		matchLeft[i] = -1
+	}
+	for i := range matchRight {
+		matchRight[i] = -1
+	}
+
+	dist := make([]int, len(leftKeys))
+	for hopcroftKarpLayers(edges, matchLeft, matchRight, dist) {
+		for u := range leftKeys {
+			if matchLeft[u] == -1 {
+				hopcroftKarpAugment(u, edges, matchLeft, matchRight, dist)
+			}
+		}
+	}
+
+	matching := make(map[string]string)
+	for u, v := range matchLeft {
+		if v != -1 {
+			matching[leftKeys[u]] = rightKeys[v]
+		}
+	}
+	return matching
+}
+
+// hopcroftKarpLayers runs a breadth-first search from every unmatched left vertex, alternating between unmatched and matched connections.
+// It stores the layer of each left vertex in dist, and returns true if an augmenting path exists
+//
+// Big-O: O(V + E) because each vertex is dequeued once
+func hopcroftKarpLayers(edges [][]int, matchLeft, matchRight, dist []int) bool {
+	q := queue.NewQueue[int]()
+	for u := range matchLeft {
+		if matchLeft[u] == -1 {
+			dist[u] = 0
+			q.Enqueue(u)
+		} else {
+			dist[u] = -1
+		}
+	}
+
+	found := false
+	for !q.IsEmpty() {
+		u, _ := q.TryDequeue()
+		for _, v := range edges[u] {
+			next := matchRight[v]
+			if next == -1 {
+				found = true
+			} else if dist[next] == -1 {
+				dist[next] = dist[u] + 1
+				q.Enqueue(next)
+			}
+		}
+	}
+	return found
+}
+
+// hopcroftKarpAugment looks for an augmenting path from u that follows the layers, and flips the path if it finds one
+//
+// Big-O: O(E) over a whole phase, because a vertex that fails is taken out of the layers
+func hopcroftKarpAugment(u int, edges [][]int, matchLeft, matchRight, dist []int) bool {
+	for _, v := range edges[u] {
+		next := matchRight[v]
+		if next == -1 || (dist[next] == dist[u]+1 && hopcroftKarpAugment(next, edges, matchLeft, matchRight, dist)) {
+			matchLeft[u] = v
+			matchRight[v] = u
+			return true
+		}
+	}
+
+	// Dead end, don't try this vertex again during this phase
+	dist[u] = -1
+	return false
+}
+
+// Hungarian finds a maximum matching between the left vertices and the rest of the graph with the lowest total weight,
+// using the Hungarian algorithm. Matching as many vertices as possible always comes first, then the weight is minimized.
+//
+// Returns a map from each matched left vertex to its right vertex, and the total weight of the matching.
+//
+// Panics if a left vertex doesn't exist, or if a connection doesn't go between the left vertices and the rest.
+//
+// Big-O: O(n^2 * m) where n is the size of the smaller side and m is the size of the larger side
+func Hungarian(g *graph.Graph, left []string) (map[string]string, int) {
+	leftKeys, rightKeys, edges := sides(g, left)
+
+	// Look up the weight of each connection. In a directed graph it might only go from right to left
+	weights := make([][]int, len(leftKeys))
+	total := 0
+	for u, key := range leftKeys {
+		weights[u] = make([]int, len(edges[u]))
+		for i, v := range edges[u] {
+			weight, ok := g.Weight(key, rightKeys[v])
+			if !ok {
+				weight, _ = g.Weight(rightKeys[v], key)
+			}
+			weights[u][i] = weight
+			total += max(weight, -weight)
+		}
+	}
+
+	// Build the cost matrix. Missing connections cost more than any set of real ones,
+	// so the algorithm only uses them when there's no other way to match a row
+	missing := 2*total + 1
+	cost := make([][]int, len(leftKeys))
+	for u := range leftKeys {
+		cost[u] = make([]int, len(rightKeys))
+		for v := range cost[u] {
+			cost[u][v] = missing
+		}
+		for i, v := range edges[u] {
+			cost[u][v] = weights[u][i]
+		}
+	}
+
+	// The algorithm needs at least as many columns as rows, so swap the sides if needed
+	transposed := len(leftKeys) > len(rightKeys)
+	if transposed {
+		swapped := make([][]int, len(rightKeys))
+		for v := range swapped {
+			swapped[v] = make([]int, len(leftKeys))
+			for u := range leftKeys {
+				swapped[v][u] = cost[u][v]
+			}
+		}
+		cost = swapped
+	}
+
+	matching := make(map[string]string)
+	weight := 0
+	for row, column := range assign(cost) {
+		if cost[row][column] == missing {
+			continue
+		}
+		weight += cost[row][column]
+		if transposed {
+			matching[leftKeys[column]] = rightKeys[row]
+		} else {
+			matching[leftKeys[row]] = rightKeys[column]
+		}
+	}
+	return matching, weight
+}
+
+// assign solves the assignment problem for an n by m cost matrix with n <= m, using potentials on the rows and columns.
+// Returns the column assigned to each row.
+//
+// Big-O: O(n^2 * m) because each row is added with a search that's O(n * m)
+func assign(cost [][]int) []int {
+	n := len(cost)
+	if n == 0 {
+		return nil
+	}
+	m := len(cost[0])
+
+	// Everything is 1-indexed, row and column 0 are a placeholder for the row being added
+	const infinity = int(^uint(0) >> 2)
+	rowPotential := make([]int, n+1)
+	columnPotential := make([]int, m+1)
+	columnRow := make([]int, m+1)
+	way := make([]int, m+1)
+
+	for row := 1; row <= n; row++ {
+		columnRow[0] = row
+		column := 0
+		minSlack := make([]int, m+1)
+		used := make([]bool, m+1)
+		for i := range minSlack {
+			minSlack[i] = infinity
+		}
+
+		// Grow the alternating tree until it reaches a free column
+		for columnRow[column] != 0 {
+			used[column] = true
+			currentRow := columnRow[column]
+			delta := infinity
+			next := 0
+			for j := 1; j <= m; j++ {
+				if used[j] {
+					continue
+				}
+				slack := cost[currentRow-1][j-1] - rowPotential[currentRow] - columnPotential[j]
+				if slack < minSlack[j] {
+					minSlack[j] = slack
+					way[j] = column
+				}
+				if minSlack[j] < delta {
+					delta = minSlack[j]
+					next = j
+				}
+			}
+			for j := 0; j <= m; j++ {
+				if used[j] {
+					rowPotential[columnRow[j]] += delta
+					columnPotential[j] -= delta
+				} else {
+					minSlack[j] -= delta
+				}
+			}
+			column = next
+		}
+
+		// Flip the path back to the placeholder column
+		for column != 0 {
+			previous := way[column]
+			columnRow[column] = columnRow[previous]
+			column = previous
+		}
+	}
+
+	result := make([]int, n)
+	for j := 1; j <= m; j++ {
+		if columnRow[j] != 0 {
+			result[columnRow[j]-1] = j - 1
+		}
+	}
+	return result
+}
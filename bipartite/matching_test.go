@@ -0,0 +1,157 @@
+package bipartite
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/robertjshirts/data-structures/graph"
+)
+
+// Workers w1-w4 and the shifts they can take
+var shifts = []string{
+	"w1,w2,w3,w4,s1,s2,s3,s4",
+	"w1,s1:4,s2:1",
+	"w2,s1:2",
+	"w3,s2:3,s3:5,s4:2",
+	"w4,s3:1",
+}
+var workers = []string{"w1", "w2", "w3", "w4"}
+
+func TestHopcroftKarp_FindsPerfectMatching(t *testing.T) {
+	// Arrange
+	g := graph.NewGraph(shifts)
+	// Act
+	matching := HopcroftKarp(g, workers)
+	// Assert
+	if len(matching) != 4 {
+		t.Errorf("Expected 4 pairs, got %v", matching)
+	}
+	assertMatching(t, g, matching)
+}
+
+func TestHopcroftKarp_FindsMaximumMatching(t *testing.T) {
+	// Arrange
+	// Both workers can only take s1
+	g := graph.NewGraph([]string{"w1,w2,s1,s2", "w1,s1:1", "w2,s1:1"})
+	// Act
+	matching := HopcroftKarp(g, []string{"w1", "w2"})
+	// Assert
+	if len(matching) != 1 {
+		t.Errorf("Expected 1 pair, got %v", matching)
+	}
+	assertMatching(t, g, matching)
+}
+
+func TestHopcroftKarp_LongAugmentingPath(t *testing.T) {
+	// Arrange
+	// A path l0-r0-l1-r1-...-l9-r9, where a greedy match of li-r(i-1) has to be undone
+	list := []string{"l0,l1,l2,l3,l4,l5,l6,l7,l8,l9,r0,r1,r2,r3,r4,r5,r6,r7,r8,r9"}
+	left := []string{}
+	for i := 0; i < 10; i++ {
+		l := "l" + strconv.Itoa(i)
+		line := l + ",r" + strconv.Itoa(i) + ":1"
+		if i > 0 {
+			line = l + ",r" + strconv.Itoa(i-1) + ":1,r" + strconv.Itoa(i) + ":1"
+		}
+		list = append(list, line)
+		left = append(left, l)
+	}
+	g := graph.NewGraph(list)
+	// Act
+	matching := HopcroftKarp(g, left)
+	// Assert
+	if len(matching) != 10 {
+		t.Errorf("Expected 10 pairs, got %v", matching)
+	}
+	assertMatching(t, g, matching)
+}
+
+func TestHopcroftKarp_PanicsOnConnectionWithinSide(t *testing.T) {
+	// Arrange
+	g := graph.NewGraph([]string{"w1,w2,s1", "w1,w2:1,s1:1"})
+	defer func() {
+		// Assert
+		if r := recover(); r == nil {
+			t.Errorf("The code did not panic")
+		}
+	}()
+	// Act
+	HopcroftKarp(g, []string{"w1", "w2"})
+}
+
+func TestHopcroftKarp_PanicsOnMissingVertex(t *testing.T) {
+	// Arrange
+	g := graph.NewGraph(shifts)
+	defer func() {
+		// Assert
+		if r := recover(); r == nil {
+			t.Errorf("The code did not panic")
+		}
+	}()
+	// Act
+	HopcroftKarp(g, []string{"w9"})
+}
+
+func TestHungarian_FindsCheapestPerfectMatching(t *testing.T) {
+	// Arrange
+	g := graph.NewGraph(shifts)
+	// Act
+	matching, weight := Hungarian(g, workers)
+	// Assert
+	// w2 has to take s1, which leaves w1 with s2, w4 with s3 and w3 with s4
+	if len(matching) != 4 || weight != 6 {
+		t.Errorf("Expected 4 pairs with a weight of 6, got %v with a weight of %v", matching, weight)
+	}
+	assertMatching(t, g, matching)
+}
+
+func TestHungarian_PrefersMorePairsOverLowerWeight(t *testing.T) {
+	// Arrange
+	// w1-s1 alone is cheaper, but w1-s2 and w2-s1 match both workers
+	g := graph.NewGraph([]string{"w1,w2,s1,s2", "w1,s1:1,s2:50", "w2,s1:50"})
+	// Act
+	matching, weight := Hungarian(g, []string{"w1", "w2"})
+	// Assert
+	if len(matching) != 2 || weight != 100 {
+		t.Errorf("Expected 2 pairs with a weight of 100, got %v with a weight of %v", matching, weight)
+	}
+}
+
+func TestHungarian_MoreWorkersThanShifts(t *testing.T) {
+	// Arrange
+	g := graph.NewGraph([]string{"w1,w2,w3,s1", "w1,s1:5", "w2,s1:3", "w3,s1:4"})
+	// Act
+	matching, weight := Hungarian(g, []string{"w1", "w2", "w3"})
+	// Assert
+	if len(matching) != 1 || matching["w2"] != "s1" || weight != 3 {
+		t.Errorf("Expected w2 to take s1 with a weight of 3, got %v with a weight of %v", matching, weight)
+	}
+}
+
+func TestHungarian_AgreesWithHopcroftKarpOnSize(t *testing.T) {
+	// Arrange
+	g := graph.NewGraph([]string{"w1,w2,w3,s1,s2,s3", "w1,s1:3", "w2,s1:2,s2:7", "w3,s1:1"})
+	left := []string{"w1", "w2", "w3"}
+	// Act
+	hungarian, _ := Hungarian(g, left)
+	hopcroftKarp := HopcroftKarp(g, left)
+	// Assert
+	if len(hungarian) != len(hopcroftKarp) {
+		t.Errorf("Expected %v pairs, got %v", len(hopcroftKarp), len(hungarian))
+	}
+	assertMatching(t, g, hungarian)
+}
+
+func assertMatching(t *testing.T, g *graph.Graph, matching map[string]string) {
+	t.Helper()
+	used := make(map[string]bool)
+	for left, right := range matching {
+		if _, ok := g.Weight(left, right); !ok {
+			t.Errorf("Expected a connection between %v and %v", left, right)
+		}
+		if used[right] {
+			t.Errorf("Expected %v to be matched once", right)
+		}
+		used[right] = true
+	}
+}
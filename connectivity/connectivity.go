@@ -0,0 +1,348 @@
+// Package connectivity finds the strongly connected components of a directed graph,
+// and the bridges and articulation points of an undirected one.
+//
+// Every search is iterative, with its own stack instead of recursion, so very deep graphs like long paths are safe.
+package connectivity
+
+import (
+	"sort"
+
+	"github.com/robertjshirts/data-structures/graph"
+)
+
+// Edge is a connection between two vertices, with From sorted before To
+type Edge struct {
+	From string
+	To   string
+}
+
+// frame is one vertex of an iterative depth-first search, and the index of the next neighbor to look at
+type frame struct {
+	vertex int
+	parent int
+	next   int
+}
+
+// indexed returns the sorted keys of the graph, and the sorted neighbors of each vertex as indexes into the keys.
+// If undirected is true, a connection in either direction counts, and self loops are dropped
+//
+// Big-O: O(V log V + E log E) because everything is sorted, so results are the same on every run
+func indexed(g *graph.Graph, undirected bool) ([]string, [][]int) {
+	if g == nil {
+		return nil, nil
+	}
+
+	keys := g.GetKeys()
+	sort.Strings(keys)
+	index := make(map[string]int, len(keys))
+	for i, key := range keys {
+		index[key] = i
+	}
+
+	neighbors := make([]map[int]bool, len(keys))
+	for i := range neighbors {
+		neighbors[i] = make(map[int]bool)
+	}
+	for i, key := range keys {
+		for neighbor := range g.Neighbors(key) {
+			j := index[neighbor]
+			if undirected {
+				if i == j {
+					continue
+				}
+				neighbors[j][i] = true
+			}
+			neighbors[i][j] = true
+		}
+	}
+
+	adjacency := make([][]int, len(keys))
+	for i := range neighbors {
+		for j := range neighbors[i] {
+			adjacency[i] = append(adjacency[i], j)
+		}
+		sort.Ints(adjacency[i])
+	}
+	return keys, adjacency
+}
+
+// components turns lists of vertex indexes into lists of keys. Each component is sorted,
+// and the components are sorted by their first key, so both SCC algorithms give the same result
+//
+// Big-O: O(V log V) because of the sorting
+func components(keys []string, groups [][]int) [][]string {
+	result := make([][]string, len(groups))
+	for i, group := range groups {
+		sort.Ints(group)
+		result[i] = make([]string, len(group))
+		for j, vertex := range group {
+			result[i][j] = keys[vertex]
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i][0] < result[j][0] })
+	return result
+}
+
+// TarjanSCC finds the strongly connected components of a directed graph with Tarjan's algorithm.
+// An undirected graph works too, its strongly connected components are just its connected components.
+//
+// Returns each component as a sorted list of keys, with the components sorted by their first key.
+//
+// Big-O: O(V + E) for the search itself, plus the sorting done to keep results stable
+func TarjanSCC(g *graph.Graph) [][]string {
+	keys, adjacency := indexed(g, false)
+
+	// index is the order each vertex was found in, low is the lowest index reachable from its subtree
+	index := make([]int, len(keys))
+	low := make([]int, len(keys))
+	onStack := make([]bool, len(keys))
+	for i := range index {
+		index[i] = -1
+	}
+
+	var groups [][]int
+	var stack []int
+	counter := 0
+	for start := range keys {
+		if index[start] != -1 {
+			continue
+		}
+
+		index[start], low[start] = counter, counter
+		counter++
+		stack = append(stack, start)
+		onStack[start] = true
+		calls := []frame{{vertex: start, parent: -1}}
+
+		for len(calls) > 0 {
+			top := &calls[len(calls)-1]
+			v := top.vertex
+
+			// Look at the next neighbor
+			if top.next < len(adjacency[v]) {
+				w := adjacency[v][top.next]
+				top.next++
+				if index[w] == -1 {
+					index[w], low[w] = counter, counter
+					counter++
+					stack = append(stack, w)
+					onStack[w] = true
+					calls = append(calls, frame{vertex: w, parent: v})
+				} else if onStack[w] {
+					low[v] = min(low[v], index[w])
+				}
+				continue
+			}
+
+			// Every neighbor is done, so v is done. If it's the root of a component, pop the component
+			calls = calls[:len(calls)-1]
+			if low[v] == index[v] {
+				var group []int
+				for {
+					w := stack[len(stack)-1]
+					stack = stack[:len(stack)-1]
+					onStack[w] = false
+					group = append(group, w)
+					if w == v {
+						break
+					}
+				}
+				groups = append(groups, group)
+			}
+			if top.parent != -1 {
+				low[top.parent] = min(low[top.parent], low[v])
+			}
+		}
+	}
+
+	return components(keys, groups)
+}
+
+// KosarajuSCC finds the strongly connected components of a directed graph with Kosaraju's algorithm.
+// It orders the vertices by when a depth-first search finishes them, then searches the reversed graph in the opposite order.
+//
+// Returns the components in the same format as TarjanSCC.
+//
+// Big-O: O(V + E) for the searches, plus the sorting done to keep results stable
+func KosarajuSCC(g *graph.Graph) [][]string {
+	keys, adjacency := indexed(g, false)
+
+	// First pass: record the order vertices are finished in
+	visited := make([]bool, len(keys))
+	order := make([]int, 0, len(keys))
+	for start := range keys {
+		if visited[start] {
+			continue
+		}
+		visited[start] = true
+		calls := []frame{{vertex: start, parent: -1}}
+		for len(calls) > 0 {
+			top := &calls[len(calls)-1]
+			if top.next < len(adjacency[top.vertex]) {
+				w := adjacency[top.vertex][top.next]
+				top.next++
+				if !visited[w] {
+					visited[w] = true
+					calls = append(calls, frame{vertex: w, parent: top.vertex})
+				}
+				continue
+			}
+			order = append(order, top.vertex)
+			calls = calls[:len(calls)-1]
+		}
+	}
+
+	// Reverse every connection
+	reversed := make([][]int, len(keys))
+	for v, neighbors := range adjacency {
+		for _, w := range neighbors {
+			reversed[w] = append(reversed[w], v)
+		}
+	}
+
+	// Second pass: everything reachable in the reversed graph from the last finished vertex is one component
+	assigned := make([]bool, len(keys))
+	var groups [][]int
+	for i := len(order) - 1; i >= 0; i-- {
+		start := order[i]
+		if assigned[start] {
+			continue
+		}
+		assigned[start] = true
+		group := []int{start}
+		stack := []int{start}
+		for len(stack) > 0 {
+			v := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for _, w := range reversed[v] {
+				if !assigned[w] {
+					assigned[w] = true
+					group = append(group, w)
+					stack = append(stack, w)
+				}
+			}
+		}
+		groups = append(groups, group)
+	}
+
+	return components(keys, groups)
+}
+
+// Bridges finds the connections of an undirected graph that would disconnect it if they were removed.
+// Connections of a directed graph are treated as undirected.
+//
+// Returns the bridges sorted by From, then To.
+//
+// Big-O: O(V + E) for the search, plus the sorting done to keep results stable
+func Bridges(g *graph.Graph) []Edge {
+	keys, adjacency := indexed(g, true)
+	bridges, _ := lowLink(adjacency)
+
+	result := make([]Edge, len(bridges))
+	for i, bridge := range bridges {
+		from, to := keys[bridge[0]], keys[bridge[1]]
+		if to < from {
+			from, to = to, from
+		}
+		result[i] = Edge{From: from, To: to}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].From != result[j].From {
+			return result[i].From < result[j].From
+		}
+		return result[i].To < result[j].To
+	})
+	return result
+}
+
+// ArticulationPoints finds the vertices of an undirected graph that would disconnect it if they were removed.
+// Connections of a directed graph are treated as undirected.
+//
+// Returns the keys of the articulation points, sorted.
+//
+// Big-O: O(V + E) for the search, plus the sorting done to keep results stable
+func ArticulationPoints(g *graph.Graph) []string {
+	keys, adjacency := indexed(g, true)
+	_, points := lowLink(adjacency)
+
+	result := make([]string, len(points))
+	for i, point := range points {
+		result[i] = keys[point]
+	}
+	sort.Strings(result)
+	return result
+}
+
+// lowLink runs an iterative depth-first search over an undirected graph, tracking the lowest discovery time
+// reachable from each subtree without going back through the parent. Returns the bridges and articulation points
+//
+// Big-O: O(V + E) because each vertex is pushed once and each connection is looked at twice
+func lowLink(adjacency [][]int) ([][2]int, []int) {
+	discovered := make([]int, len(adjacency))
+	low := make([]int, len(adjacency))
+	isPoint := make([]bool, len(adjacency))
+	for i := range discovered {
+		discovered[i] = -1
+	}
+
+	var bridges [][2]int
+	var points []int
+	counter := 0
+	for root := range adjacency {
+		if discovered[root] != -1 {
+			continue
+		}
+
+		discovered[root], low[root] = counter, counter
+		counter++
+		rootChildren := 0
+		calls := []frame{{vertex: root, parent: -1}}
+
+		for len(calls) > 0 {
+			top := &calls[len(calls)-1]
+			v := top.vertex
+
+			if top.next < len(adjacency[v]) {
+				w := adjacency[v][top.next]
+				top.next++
+				if w == top.parent {
+					// Going straight back to the parent isn't a cycle
+					continue
+				}
+				if discovered[w] == -1 {
+					discovered[w], low[w] = counter, counter
+					counter++
+					if v == root {
+						rootChildren++
+					}
+					calls = append(calls, frame{vertex: w, parent: v})
+				} else {
+					low[v] = min(low[v], discovered[w])
+				}
+				continue
+			}
+
+			// v is done, report what its subtree says about its parent
+			calls = calls[:len(calls)-1]
+			parent := top.parent
+			if parent == -1 {
+				continue
+			}
+			low[parent] = min(low[parent], low[v])
+			if low[v] > discovered[parent] {
+				bridges = append(bridges, [2]int{parent, v})
+			}
+			if parent != root && low[v] >= discovered[parent] && !isPoint[parent] {
+				isPoint[parent] = true
+				points = append(points, parent)
+			}
+		}
+
+		// The root is only an articulation point if the search had to leave it more than once
+		if rootChildren > 1 {
+			points = append(points, root)
+		}
+	}
+
+	return bridges, points
+}
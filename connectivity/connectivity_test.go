@@ -0,0 +1,245 @@
+package connectivity
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/robertjshirts/data-structures/graph"
+	"github.com/robertjshirts/data-structures/graph_gen"
+)
+
+var sccAlgorithms = map[string]func(*graph.Graph) [][]string{
+	"Tarjan":   TarjanSCC,
+	"Kosaraju": KosarajuSCC,
+}
+
+func TestSCC_FindsComponents(t *testing.T) {
+	for name, scc := range sccAlgorithms {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			g := graph.NewDirectedGraph([]string{
+				"a,b,c,d,e,f,g,h",
+				"a,b:1",
+				"b,c:1,e:1,f:1",
+				"c,d:1,g:1",
+				"d,c:1,h:1",
+				"e,a:1,f:1",
+				"f,g:1",
+				"g,f:1",
+				"h,d:1,g:1",
+			})
+			want := [][]string{{"a", "b", "e"}, {"c", "d", "h"}, {"f", "g"}}
+			// Act
+			got := scc(g)
+			// Assert
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Expected %v, got %v", want, got)
+			}
+		})
+	}
+}
+
+func TestSCC_EmptyGraph(t *testing.T) {
+	for name, scc := range sccAlgorithms {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			g := graph.EmptyDirectedGraph()
+			// Act
+			got := scc(g)
+			// Assert
+			if len(got) != 0 {
+				t.Errorf("Expected no components, got %v", got)
+			}
+		})
+	}
+}
+
+func TestSCC_DeepPath(t *testing.T) {
+	for name, scc := range sccAlgorithms {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			// A path that goes back to the start, so the whole thing is one component
+			n := 100000
+			g := graph.EmptyDirectedGraph()
+			for i := 0; i < n; i++ {
+				g.AddVertex(graph_gen.Key(i))
+			}
+			for i := 0; i < n; i++ {
+				g.AddConnection(graph_gen.Key(i), graph_gen.Key((i+1)%n), 1)
+			}
+			// Act
+			got := scc(g)
+			// Assert
+			if len(got) != 1 || len(got[0]) != n {
+				t.Errorf("Expected one component of %v vertices, got %v components", n, len(got))
+			}
+		})
+	}
+}
+
+func TestSCC_AlgorithmsAgreeOnRandomGraphs(t *testing.T) {
+	rng := rand.New(rand.NewSource(31))
+	for i := 0; i < 50; i++ {
+		// Arrange
+		g := graph.EmptyDirectedGraph()
+		for v := 0; v < 30; v++ {
+			g.AddVertex(graph_gen.Key(v))
+		}
+		for e := 0; e < 45; e++ {
+			g.AddConnection(graph_gen.Key(rng.Intn(30)), graph_gen.Key(rng.Intn(30)), 1)
+		}
+		// Act
+		tarjan := TarjanSCC(g)
+		kosaraju := KosarajuSCC(g)
+		// Assert
+		if !reflect.DeepEqual(tarjan, kosaraju) {
+			t.Fatalf("Expected both algorithms to agree, got %v and %v", tarjan, kosaraju)
+		}
+	}
+}
+
+func TestBridges_FindsBridges(t *testing.T) {
+	// Arrange
+	// Two triangles joined by c-d, with a tail d-g
+	g := graph.NewGraph([]string{"a,b,c,d,e,f,g", "a,b:1,c:1", "b,c:1", "c,d:1", "d,e:1,f:1,g:1", "e,f:1"})
+	want := []Edge{{From: "c", To: "d"}, {From: "d", To: "g"}}
+	// Act
+	got := Bridges(g)
+	// Assert
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestArticulationPoints_FindsPoints(t *testing.T) {
+	// Arrange
+	g := graph.NewGraph([]string{"a,b,c,d,e,f,g", "a,b:1,c:1", "b,c:1", "c,d:1", "d,e:1,f:1,g:1", "e,f:1"})
+	want := []string{"c", "d"}
+	// Act
+	got := ArticulationPoints(g)
+	// Assert
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestArticulationPoints_RootWithTwoChildren(t *testing.T) {
+	// Arrange
+	g := graph.NewGraph([]string{"a,b,c", "a,b:1,c:1"})
+	// Act
+	got := ArticulationPoints(g)
+	// Assert
+	if !reflect.DeepEqual(got, []string{"a"}) {
+		t.Errorf("Expected [a], got %v", got)
+	}
+}
+
+func TestBridges_CycleHasNoBridges(t *testing.T) {
+	// Arrange
+	g := graph.NewGraph([]string{"a,b,c,d", "a,b:1,d:1", "c,b:1,d:1"})
+	// Act
+	bridges := Bridges(g)
+	points := ArticulationPoints(g)
+	// Assert
+	if len(bridges) != 0 || len(points) != 0 {
+		t.Errorf("Expected no bridges or articulation points, got %v and %v", bridges, points)
+	}
+}
+
+func TestBridges_DeepPath(t *testing.T) {
+	// Arrange
+	n := 100000
+	g := graph.EmptyGraph()
+	for i := 0; i < n; i++ {
+		g.AddVertex(graph_gen.Key(i))
+	}
+	for i := 0; i+1 < n; i++ {
+		g.AddConnection(graph_gen.Key(i), graph_gen.Key(i+1), 1)
+	}
+	// Act
+	bridges := Bridges(g)
+	points := ArticulationPoints(g)
+	// Assert
+	if len(bridges) != n-1 {
+		t.Errorf("Expected %v bridges, got %v", n-1, len(bridges))
+	}
+	if len(points) != n-2 {
+		t.Errorf("Expected %v articulation points, got %v", n-2, len(points))
+	}
+}
+
+func TestBridgesAndPoints_MatchBruteForce(t *testing.T) {
+	gen := graph_gen.NewGenerator(37, graph_gen.Constant(1))
+	for i := 0; i < 30; i++ {
+		// Arrange
+		g := gen.ErdosRenyi(15, 0.15)
+		// Act
+		bridges := Bridges(g)
+		points := ArticulationPoints(g)
+		// Assert
+		var wantBridges []Edge
+		var wantPoints []string
+		base := countComponents(g, "", Edge{})
+		for _, key := range g.GetKeys() {
+			if countComponents(g, key, Edge{}) > base-boolToInt(g.Degree(key) == 0) {
+				wantPoints = append(wantPoints, key)
+			}
+			for neighbor := range g.Neighbors(key) {
+				if key < neighbor && countComponents(g, "", Edge{From: key, To: neighbor}) > base {
+					wantBridges = append(wantBridges, Edge{From: key, To: neighbor})
+				}
+			}
+		}
+		sort.Slice(wantBridges, func(i, j int) bool {
+			if wantBridges[i].From != wantBridges[j].From {
+				return wantBridges[i].From < wantBridges[j].From
+			}
+			return wantBridges[i].To < wantBridges[j].To
+		})
+		sort.Strings(wantPoints)
+		if len(wantBridges) != len(bridges) || (len(bridges) > 0 && !reflect.DeepEqual(wantBridges, bridges)) {
+			t.Errorf("Expected bridges %v, got %v", wantBridges, bridges)
+		}
+		if len(wantPoints) != len(points) || (len(points) > 0 && !reflect.DeepEqual(wantPoints, points)) {
+			t.Errorf("Expected articulation points %v, got %v", wantPoints, points)
+		}
+	}
+}
+
+// countComponents counts the connected components of g without the skipped vertex and edge
+func countComponents(g *graph.Graph, skipVertex string, skipEdge Edge) int {
+	seen := map[string]bool{}
+	count := 0
+	for _, start := range g.GetKeys() {
+		if start == skipVertex || seen[start] {
+			continue
+		}
+		count++
+		seen[start] = true
+		stack := []string{start}
+		for len(stack) > 0 {
+			key := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for neighbor := range g.Neighbors(key) {
+				if neighbor == skipVertex || seen[neighbor] {
+					continue
+				}
+				if (key == skipEdge.From && neighbor == skipEdge.To) || (key == skipEdge.To && neighbor == skipEdge.From) {
+					continue
+				}
+				seen[neighbor] = true
+				stack = append(stack, neighbor)
+			}
+		}
+	}
+	return count
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
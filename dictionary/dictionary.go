@@ -1,21 +1,25 @@
 package dictionary
 
 import (
+	"slices"
+
 	"github.com/robertjshirts/data-structures/kvp"
 )
 
-type dictionary[K kvp.KeyTypes, V any] struct {
+// Dictionary maps keys to values. It keeps its pairs in a slice, so every lookup is a linear scan.
+// A Dictionary is not safe for concurrent use, see the sync package for that.
+type Dictionary[K kvp.KeyTypes, V any] struct {
 	elements []kvp.KeyValuePair[K, V]
 }
 
-func NewDict[K kvp.KeyTypes, V any]() *dictionary[K, V] {
+func NewDict[K kvp.KeyTypes, V any]() *Dictionary[K, V] {
 	// Please note that this is a SLOW dictionary
 	// We don't got no O(1) access times here.
 	// It's O(n). (bc i do not know how hashmap :( sad)
-	return &dictionary[K, V]{}
+	return &Dictionary[K, V]{}
 }
 
-func (d *dictionary[K, V]) Add(key K, value V) {
+func (d *Dictionary[K, V]) Add(key K, value V) {
 	// Look for duplicate key, and replace if exists
 	for i, element := range d.elements {
 		if element.Key() == key {
@@ -28,7 +32,7 @@ func (d *dictionary[K, V]) Add(key K, value V) {
 	d.elements = append(d.elements, kvp.NewKVP(key, value))
 }
 
-func (d *dictionary[K, V]) AddKVP(kvp kvp.KeyValuePair[K, V]) {
+func (d *Dictionary[K, V]) AddKVP(kvp kvp.KeyValuePair[K, V]) {
 	// Look for duplicate key, and replace if exists
 	for i, element := range d.elements {
 		if element.Key() == kvp.Key() {
@@ -41,7 +45,7 @@ func (d *dictionary[K, V]) AddKVP(kvp kvp.KeyValuePair[K, V]) {
 	d.elements = append(d.elements, kvp)
 }
 
-func (d *dictionary[K, V]) Get(key K) *V {
+func (d *Dictionary[K, V]) Get(key K) *V {
 	// Just a wrapper around the GetKVP func
 	kvp := d.GetKVP(key)
 	if kvp == nil {
@@ -51,7 +55,7 @@ func (d *dictionary[K, V]) Get(key K) *V {
 	return &value
 }
 
-func (d *dictionary[K, V]) GetKVP(key K) *kvp.KeyValuePair[K, V] {
+func (d *Dictionary[K, V]) GetKVP(key K) *kvp.KeyValuePair[K, V] {
 	// Iterate through slice of elements
 	for _, element := range d.elements {
 		if element.Key() == key {
@@ -63,7 +67,7 @@ func (d *dictionary[K, V]) GetKVP(key K) *kvp.KeyValuePair[K, V] {
 	return nil
 }
 
-func (d *dictionary[K, V]) Remove(key K) bool {
+func (d *Dictionary[K, V]) Remove(key K) bool {
 	// Iterate through the elements
 	for i, element := range d.elements {
 		if element.Key() == key {
@@ -79,7 +83,16 @@ func (d *dictionary[K, V]) Remove(key K) bool {
 	return false
 }
 
-func (d *dictionary[K, V]) GetKVPs() []kvp.KeyValuePair[K, V] {
+// Len returns the number of keys in the dictionary
+//
+// Time complexity: O(1) because it's the length of the slice
+func (d *Dictionary[K, V]) Len() int {
+	return len(d.elements)
+}
+
+// GetKVPs returns every key-value pair in the order the keys were first added.
+// The slice is a copy, so changing it or later changes to the dictionary don't affect each other.
+func (d *Dictionary[K, V]) GetKVPs() []kvp.KeyValuePair[K, V] {
 	// Return a copy, not the reference to the array
-	return d.elements
+	return slices.Clone(d.elements)
 }
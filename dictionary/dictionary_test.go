@@ -34,3 +34,38 @@ func TestAddAndRetrieveKVP(t *testing.T) {
 		t.Fatalf("Expected dict.Get(%s) to return %s, got %s", key, expected, actual)
 	}
 }
+
+func TestGetKVPsReturnsCopy(t *testing.T) {
+	// Arrange
+	dict := NewDict[string, string]()
+	dict.Add("a", "1")
+	dict.Add("b", "2")
+	// Act
+	pairs := dict.GetKVPs()
+	pairs[0] = kvp.NewKVP("c", "3")
+	dict.Remove("a")
+	// Assert
+	if actual := *(dict.Get("b")); actual != "2" {
+		t.Fatalf("Expected dict.Get(b) to return 2, got %s", actual)
+	}
+	if dict.Get("c") != nil {
+		t.Fatalf("Expected changing the returned slice not to add c to the dictionary")
+	}
+	if pairs[1].Key() != "b" {
+		t.Fatalf("Expected removing a to leave the returned slice alone, got %v", pairs)
+	}
+}
+
+func TestLenCountsKeys(t *testing.T) {
+	// Arrange
+	dict := NewDict[string, string]()
+	dict.Add("a", "1")
+	dict.Add("b", "2")
+	dict.Add("a", "3")
+	// Act
+	dict.Remove("b")
+	// Assert
+	if actual := dict.Len(); actual != 1 {
+		t.Fatalf("Expected dict.Len() to return 1, got %d", actual)
+	}
+}
@@ -0,0 +1,143 @@
+// Package flow finds the maximum flow and minimum cut between two vertices of a graph,
+// using the weight of each connection as its capacity.
+//
+// Directed graphs are used as they are. An undirected graph is treated as having an arc in both directions,
+// each with the full capacity of the edge.
+package flow
+
+import (
+	"github.com/robertjshirts/data-structures/graph"
+	"github.com/robertjshirts/data-structures/queue"
+)
+
+// Edge is a connection that crosses the minimum cut
+type Edge struct {
+	From     string
+	To       string
+	Capacity int
+}
+
+// Result is a maximum flow and the minimum cut that proves it
+type Result struct {
+	// Value is the total flow from the source to the sink
+	Value int
+	// Flows maps each connection of the graph, from then to, to the flow going through it
+	Flows map[string]map[string]int
+	// SourceSide holds the vertices that can still be reached from the source, in sorted order
+	SourceSide []string
+	// SinkSide holds the rest of the vertices, in sorted order
+	SinkSide []string
+	// Cut holds the connections from SourceSide to SinkSide. Their capacities add up to Value
+	Cut []Edge
+}
+
+// Flow returns the flow going through the connection from one vertex to another, or 0 if there's no such connection
+//
+// Big-O: O(1) because it's two map lookups
+func (r *Result) Flow(from, to string) int {
+	return r.Flows[from][to]
+}
+
+// EdmondsKarp finds the maximum flow from source to sink with the Edmonds-Karp algorithm,
+// which keeps pushing flow along the shortest path that still has capacity left.
+//
+// Panics if the source or sink don't exist, if they are the same vertex, or if a capacity is negative.
+//
+// Big-O: O(V * E^2) because there are at most O(V * E) augmenting paths, and each breadth-first search is O(E)
+func EdmondsKarp(g *graph.Graph, source, sink string) *Result {
+	n := newNetwork(g, source, sink)
+
+	for {
+		// Find the shortest path to the sink, remembering the arc used to reach each vertex
+		parent := make([]int, len(n.keys))
+		parentArc := make([]int, len(n.keys))
+		for i := range parent {
+			parent[i] = -1
+		}
+		parent[n.source] = n.source
+
+		q := queue.NewQueue[int]()
+		q.Enqueue(n.source)
+		for !q.IsEmpty() && parent[n.sink] == -1 {
+			current, _ := q.TryDequeue()
+			for i, a := range n.arcs[current] {
+				if parent[a.to] == -1 && a.capacity-a.flow > 0 {
+					parent[a.to] = current
+					parentArc[a.to] = i
+					q.Enqueue(a.to)
+				}
+			}
+		}
+
+		// If the sink can't be reached, the flow is maximal
+		if parent[n.sink] == -1 {
+			return n.result()
+		}
+
+		// Find the bottleneck of the path, then push that much flow along it
+		bottleneck := -1
+		for v := n.sink; v != n.source; v = parent[v] {
+			a := n.arcs[parent[v]][parentArc[v]]
+			if bottleneck == -1 || a.capacity-a.flow < bottleneck {
+				bottleneck = a.capacity - a.flow
+			}
+		}
+		for v := n.sink; v != n.source; v = parent[v] {
+			n.push(parent[v], parentArc[v], bottleneck)
+		}
+	}
+}
+
+// Dinic finds the maximum flow from source to sink with Dinic's algorithm. Each phase builds a level graph
+// with a breadth-first search, then pushes a blocking flow through it with depth-first searches.
+//
+// Panics if the source or sink don't exist, if they are the same vertex, or if a capacity is negative.
+//
+// Big-O: O(V^2 * E) because there are at most V phases, and each blocking flow is O(V * E)
+func Dinic(g *graph.Graph, source, sink string) *Result {
+	n := newNetwork(g, source, sink)
+
+	for {
+		level := n.levels()
+		if level[n.sink] == -1 {
+			return n.result()
+		}
+
+		// next holds the first arc of each vertex that might still have room, so dead ends are only tried once per phase
+		next := make([]int, len(n.keys))
+		for {
+			pushed := n.blockingPush(n.source, -1, level, next)
+			if pushed == 0 {
+				break
+			}
+		}
+	}
+}
+
+// blockingPush pushes as much flow as it can, up to limit, from vertex to the sink along the level graph.
+// A limit of -1 means there is no limit. Returns the amount of flow pushed
+//
+// Big-O: O(V * E) over a whole phase, because each arc is either saturated or skipped at most once per path
+func (n *network) blockingPush(vertex, limit int, level, next []int) int {
+	if vertex == n.sink {
+		return limit
+	}
+
+	for ; next[vertex] < len(n.arcs[vertex]); next[vertex]++ {
+		a := n.arcs[vertex][next[vertex]]
+		room := a.capacity - a.flow
+		if room <= 0 || level[a.to] != level[vertex]+1 {
+			continue
+		}
+
+		if limit != -1 && limit < room {
+			room = limit
+		}
+		if pushed := n.blockingPush(a.to, room, level, next); pushed > 0 {
+			n.push(vertex, next[vertex], pushed)
+			return pushed
+		}
+	}
+
+	return 0
+}
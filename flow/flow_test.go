@@ -0,0 +1,197 @@
+package flow
+
+import (
+	"testing"
+
+	"github.com/robertjshirts/data-structures/graph"
+	"github.com/robertjshirts/data-structures/graph_gen"
+)
+
+// The flow network from CLRS, with a maximum flow of 23
+var classic = []string{
+	"s,v1,v2,v3,v4,t",
+	"s,v1:16,v2:13",
+	"v1,v3:12",
+	"v2,v1:4,v4:14",
+	"v3,v2:9,t:20",
+	"v4,v3:7,t:4",
+}
+
+var algorithms = map[string]func(*graph.Graph, string, string) *Result{
+	"EdmondsKarp": EdmondsKarp,
+	"Dinic":       Dinic,
+}
+
+func TestMaxFlow_ClassicNetwork(t *testing.T) {
+	for name, maxFlow := range algorithms {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			g := graph.NewDirectedGraph(classic)
+			// Act
+			result := maxFlow(g, "s", "t")
+			// Assert
+			if result.Value != 23 {
+				t.Errorf("Expected a flow of 23, got %v", result.Value)
+			}
+			assertValidFlow(t, g, result, "s", "t")
+		})
+	}
+}
+
+func TestMaxFlow_ClassicNetworkCut(t *testing.T) {
+	for name, maxFlow := range algorithms {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			g := graph.NewDirectedGraph(classic)
+			// Act
+			result := maxFlow(g, "s", "t")
+			// Assert
+			want := []string{"s", "v1", "v2", "v4"}
+			if len(result.SourceSide) != len(want) {
+				t.Fatalf("Expected source side %v, got %v", want, result.SourceSide)
+			}
+			for i := range want {
+				if result.SourceSide[i] != want[i] {
+					t.Errorf("Expected source side %v, got %v", want, result.SourceSide)
+				}
+			}
+		})
+	}
+}
+
+func TestMaxFlow_UnreachableSink(t *testing.T) {
+	for name, maxFlow := range algorithms {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			g := graph.NewDirectedGraph([]string{"a,b,c", "a,b:5", "c,b:3"})
+			// Act
+			result := maxFlow(g, "a", "c")
+			// Assert
+			if result.Value != 0 {
+				t.Errorf("Expected a flow of 0, got %v", result.Value)
+			}
+			if len(result.Cut) != 0 {
+				t.Errorf("Expected an empty cut, got %v", result.Cut)
+			}
+			if len(result.SinkSide) != 1 || result.SinkSide[0] != "c" {
+				t.Errorf("Expected sink side [c], got %v", result.SinkSide)
+			}
+		})
+	}
+}
+
+func TestMaxFlow_UndirectedGraph(t *testing.T) {
+	for name, maxFlow := range algorithms {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			g := graph.NewGraph([]string{"a,b,c,d", "a,b:3,c:2", "b,d:2,c:5", "c,d:3"})
+			// Act
+			result := maxFlow(g, "a", "d")
+			// Assert
+			if result.Value != 5 {
+				t.Errorf("Expected a flow of 5, got %v", result.Value)
+			}
+			assertValidFlow(t, g, result, "a", "d")
+		})
+	}
+}
+
+func TestMaxFlow_PanicsOnMissingSource(t *testing.T) {
+	for name, maxFlow := range algorithms {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			g := graph.NewDirectedGraph(classic)
+			defer func() {
+				// Assert
+				if r := recover(); r == nil {
+					t.Errorf("The code did not panic")
+				}
+			}()
+			// Act
+			maxFlow(g, "x", "t")
+		})
+	}
+}
+
+func TestMaxFlow_PanicsOnSameSourceAndSink(t *testing.T) {
+	for name, maxFlow := range algorithms {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			g := graph.NewDirectedGraph(classic)
+			defer func() {
+				// Assert
+				if r := recover(); r == nil {
+					t.Errorf("The code did not panic")
+				}
+			}()
+			// Act
+			maxFlow(g, "s", "s")
+		})
+	}
+}
+
+func TestMaxFlow_AlgorithmsAgreeOnRandomGraphs(t *testing.T) {
+	gen := graph_gen.NewGenerator(17, graph_gen.Uniform(1, 20))
+	for i := 0; i < 25; i++ {
+		// Arrange
+		g := gen.ErdosRenyi(30, 0.15)
+		source, sink := graph_gen.Key(0), graph_gen.Key(29)
+		// Act
+		edmondsKarp := EdmondsKarp(g, source, sink)
+		dinic := Dinic(g, source, sink)
+		// Assert
+		if edmondsKarp.Value != dinic.Value {
+			t.Fatalf("Expected both algorithms to agree, got %v and %v", edmondsKarp.Value, dinic.Value)
+		}
+		assertValidFlow(t, g, edmondsKarp, source, sink)
+		assertValidFlow(t, g, dinic, source, sink)
+	}
+}
+
+func BenchmarkEdmondsKarp(b *testing.B) {
+	g := graph_gen.NewGenerator(1, graph_gen.Uniform(1, 100)).Connected(300, 0.05)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EdmondsKarp(g, graph_gen.Key(0), graph_gen.Key(299))
+	}
+}
+
+func BenchmarkDinic(b *testing.B) {
+	g := graph_gen.NewGenerator(1, graph_gen.Uniform(1, 100)).Connected(300, 0.05)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Dinic(g, graph_gen.Key(0), graph_gen.Key(299))
+	}
+}
+
+// assertValidFlow checks capacities, conservation of flow, and that the cut matches the flow value
+func assertValidFlow(t *testing.T, g *graph.Graph, result *Result, source, sink string) {
+	t.Helper()
+	balance := make(map[string]int)
+	for _, from := range g.GetKeys() {
+		for to, capacity := range g.Neighbors(from) {
+			flow := result.Flow(from, to)
+			if from != to && (flow < 0 || flow > capacity) {
+				t.Errorf("Expected flow on %v->%v between 0 and %v, got %v", from, to, capacity, flow)
+			}
+			balance[from] -= flow
+			balance[to] += flow
+		}
+	}
+	for key, b := range balance {
+		if key != source && key != sink && b != 0 {
+			t.Errorf("Expected flow into %v to match flow out, off by %v", key, b)
+		}
+	}
+	if balance[sink] != result.Value {
+		t.Errorf("Expected %v to reach the sink, got %v", result.Value, balance[sink])
+	}
+
+	cut := 0
+	for _, edge := range result.Cut {
+		cut += edge.Capacity
+	}
+	if cut != result.Value {
+		t.Errorf("Expected the cut capacity to be %v, got %v", result.Value, cut)
+	}
+}
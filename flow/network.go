@@ -0,0 +1,157 @@
+package flow
+
+import (
+	"sort"
+
+	"github.com/robertjshirts/data-structures/graph"
+	"github.com/robertjshirts/data-structures/queue"
+)
+
+// arc is one direction of an edge in the residual network.
+// Every arc from the graph is paired with a reverse arc, so flow can be pushed back
+type arc struct {
+	to       int
+	reverse  int
+	capacity int
+	flow     int
+	original bool
+}
+
+// network is the residual network of a graph, with vertices numbered by their index in keys
+type network struct {
+	keys   []string
+	arcs   [][]arc
+	source int
+	sink   int
+}
+
+// newNetwork builds the residual network of g, using each connection's weight as its capacity.
+// Vertices and connections are added in sorted order, so the results don't depend on map iteration order.
+//
+// Panics if the source or sink don't exist, if they are the same vertex, or if a capacity is negative.
+//
+// Big-O: O(V log V + E log E) because the keys and connections are sorted
+func newNetwork(g *graph.Graph, source, sink string) *network {
+	if g == nil || !g.HasVertex(source) {
+		panic("Source vertex doesn't exist")
+	}
+	if !g.HasVertex(sink) {
+		panic("Sink vertex doesn't exist")
+	}
+	if source == sink {
+		panic("Source and sink are the same vertex")
+	}
+
+	keys := g.GetKeys()
+	sort.Strings(keys)
+	index := make(map[string]int, len(keys))
+	for i, key := range keys {
+		index[key] = i
+	}
+
+	n := &network{
+		keys:   keys,
+		arcs:   make([][]arc, len(keys)),
+		source: index[source],
+		sink:   index[sink],
+	}
+
+	for from, key := range keys {
+		neighbors := g.Neighbors(key)
+		neighborKeys := make([]string, 0, len(neighbors))
+		for neighbor := range neighbors {
+			neighborKeys = append(neighborKeys, neighbor)
+		}
+		sort.Strings(neighborKeys)
+
+		for _, neighbor := range neighborKeys {
+			capacity := neighbors[neighbor]
+			if capacity < 0 {
+				panic("Capacity is negative")
+			}
+			to := index[neighbor]
+			// Self loops can never carry flow to the sink
+			if to == from {
+				continue
+			}
+			n.arcs[from] = append(n.arcs[from], arc{to: to, reverse: len(n.arcs[to]), capacity: capacity, original: true})
+			n.arcs[to] = append(n.arcs[to], arc{to: from, reverse: len(n.arcs[from]) - 1})
+		}
+	}
+
+	return n
+}
+
+// push sends amount of flow through the arc, and takes it back from the reverse arc
+//
+// Big-O: O(1) because it's just changing two fields
+func (n *network) push(from, arcIndex, amount int) {
+	a := &n.arcs[from][arcIndex]
+	a.flow += amount
+	n.arcs[a.to][a.reverse].flow -= amount
+}
+
+// levels runs a breadth-first search from the source over arcs with capacity left, and returns
+// the distance of each vertex from the source, or -1 if it can't be reached
+//
+// Big-O: O(V + E) because each vertex is dequeued once and each of its arcs is checked once
+func (n *network) levels() []int {
+	level := make([]int, len(n.keys))
+	for i := range level {
+		level[i] = -1
+	}
+	level[n.source] = 0
+
+	q := queue.NewQueue[int]()
+	q.Enqueue(n.source)
+	for !q.IsEmpty() {
+		current, _ := q.TryDequeue()
+		for _, a := range n.arcs[current] {
+			if level[a.to] == -1 && a.capacity-a.flow > 0 {
+				level[a.to] = level[current] + 1
+				q.Enqueue(a.to)
+			}
+		}
+	}
+
+	return level
+}
+
+// result collects the flow on each arc from the graph and the minimum cut
+//
+// Big-O: O(V + E) because it runs one breadth-first search and checks each arc once
+func (n *network) result() *Result {
+	result := &Result{
+		Flows: make(map[string]map[string]int, len(n.keys)),
+	}
+
+	// Whatever the source can still reach is on its side of the cut
+	level := n.levels()
+
+	for from, arcs := range n.arcs {
+		if level[from] != -1 {
+			result.SourceSide = append(result.SourceSide, n.keys[from])
+		} else {
+			result.SinkSide = append(result.SinkSide, n.keys[from])
+		}
+
+		for _, a := range arcs {
+			if !a.original {
+				continue
+			}
+			if result.Flows[n.keys[from]] == nil {
+				result.Flows[n.keys[from]] = make(map[string]int)
+			}
+			result.Flows[n.keys[from]][n.keys[a.to]] = a.flow
+			if level[from] != -1 && level[a.to] == -1 {
+				result.Cut = append(result.Cut, Edge{From: n.keys[from], To: n.keys[a.to], Capacity: a.capacity})
+			}
+		}
+	}
+
+	for _, a := range n.arcs[n.source] {
+		result.Value += a.flow
+	}
+
+	return result
+}
@@ -1,19 +1,33 @@
 package graph
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 )
 
+// Graph is a weighted graph, undirected unless it was made with EmptyDirectedGraph or NewDirectedGraph.
+// Its fields are unexported so that the vertex count, the edge count, and the symmetry of every
+// connection can't be changed from outside the package.
 type Graph struct {
-	Vertices map[string]*Vertex
-	Count    int
+	vertices map[string]*Vertex
+	count    int
+	edges    int
+	directed bool
 }
 
 // EmptyGraph creates a new empty graph
 func EmptyGraph() *Graph {
 	return &Graph{
-		Vertices: make(map[string]*Vertex),
+		vertices: make(map[string]*Vertex),
+	}
+}
+
+// EmptyDirectedGraph creates a new empty directed graph, where AddConnection only connects the first vertex to the second
+func EmptyDirectedGraph() *Graph {
+	return &Graph{
+		vertices: make(map[string]*Vertex),
+		directed: true,
 	}
 }
 
@@ -23,15 +37,23 @@ func EmptyGraph() *Graph {
 //
 // Big-O: O(n^2) because it loops through each vertex and each connection of the vertex
 func NewGraph(adjacencyList []string) *Graph {
+	return parseAdjacencyList(EmptyGraph(), adjacencyList)
+}
+
+// NewDirectedGraph creates a new directed graph based on the adjacencyList provided, in the same format as NewGraph.
+// Each connection only goes from the vertex at the start of its line to the connecting vertex.
+//
+// Big-O: O(n^2) because it loops through each vertex and each connection of the vertex
+func NewDirectedGraph(adjacencyList []string) *Graph {
+	return parseAdjacencyList(EmptyDirectedGraph(), adjacencyList)
+}
+
+// parseAdjacencyList adds the vertices and connections described by adjacencyList to graph, and returns it
+func parseAdjacencyList(graph *Graph, adjacencyList []string) *Graph {
 	if len(adjacencyList) == 0 {
 		panic("Adjacency list is empty")
 	}
 
-	// Create a new graph
-	graph := &Graph{
-		Vertices: make(map[string]*Vertex),
-	}
-
 	// Parse the Vertices
 	for _, key := range strings.Split(adjacencyList[0], ",") {
 		graph.AddVertex(key)
@@ -62,7 +84,7 @@ func NewGraph(adjacencyList []string) *Graph {
 			if graph.GetVertex(connection[0]) == nil {
 				panic("Connection vertex doesn't exist")
 			}
-			graph.AddConnection(vertex.Key, connection[0], weight)
+			graph.AddConnection(vertex.key, connection[0], weight)
 		}
 	}
 
@@ -75,14 +97,14 @@ func NewGraph(adjacencyList []string) *Graph {
 // Big-O: O(1) because it just creates a new vertex
 func (g *Graph) AddVertex(key string) *Vertex {
 	// Check for duplicate
-	if _, ok := g.Vertices[key]; ok {
-		return g.Vertices[key]
+	if _, ok := g.vertices[key]; ok {
+		return g.vertices[key]
 	}
 
 	// Create vertex
 	vertex := NewVertex(key)
-	g.Vertices[key] = vertex
-	g.Count++
+	g.vertices[key] = vertex
+	g.count++
 	return vertex
 }
 
@@ -90,36 +112,177 @@ func (g *Graph) AddVertex(key string) *Vertex {
 //
 // Big-O: O(1) because it just returns the vertex
 func (g *Graph) GetVertex(key string) *Vertex {
-	return g.Vertices[key]
+	return g.vertices[key]
+}
+
+// HasVertex returns true if a vertex with the provided key is in the graph
+//
+// Big-O: O(1) because it's a map lookup
+func (g *Graph) HasVertex(key string) bool {
+	_, ok := g.vertices[key]
+	return ok
 }
 
 // GetKeys returns a slice of all the keys in the graph, in no particular order
 //
 // Big-O: O(n) because it loops through each vertex
 func (g *Graph) GetKeys() []string {
-	keys := make([]string, 0, len(g.Vertices))
-	for key := range g.Vertices {
+	keys := make([]string, 0, len(g.vertices))
+	for key := range g.vertices {
 		keys = append(keys, key)
 	}
 	return keys
 }
 
 // AddConnection adds a connection between the two vertices with the provided keys
+// If the graph is directed, the connection only goes from key1 to key2.
 // If either of the vertices doesn't exist, nothing is done
 //
 // Big-O: O(1) because it just adds a connection to the vertex
 func (g *Graph) AddConnection(key1, key2 string, weight int) {
 	// Check if vertices exist
-	vertex1, ok := g.Vertices[key1]
+	vertex1, ok := g.vertices[key1]
 	if !ok {
 		return
 	}
-	vertex2, ok := g.Vertices[key2]
+	vertex2, ok := g.vertices[key2]
 	if !ok {
 		return
 	}
 
+	// Only count the edge if it's new, a duplicate just updates the weight
+	if _, ok := vertex1.connections[key2]; !ok {
+		g.edges++
+	}
+
 	// Add the connection
-	vertex1.AddConnection(key2, weight)
-	vertex2.AddConnection(key1, weight)
+	vertex1.addConnection(key2, weight)
+	if !g.directed {
+		vertex2.addConnection(key1, weight)
+	}
+}
+
+// Directed returns true if the graph is directed
+//
+// Big-O: O(1) because it just returns the field
+func (g *Graph) Directed() bool {
+	return g.directed
+}
+
+// Neighbors returns a copy of the connections of the vertex with the provided key,
+// mapping each neighbor's key to the weight of the edge. Returns nil if the vertex doesn't exist.
+// For a directed graph, only the connections going out of the vertex are returned
+//
+// Big-O: O(n) because it copies each connection of the vertex
+func (g *Graph) Neighbors(key string) map[string]int {
+	vertex, ok := g.vertices[key]
+	if !ok {
+		return nil
+	}
+	return vertex.Connections()
+}
+
+// EachNeighbor calls fn with the key and edge weight of each connection of the vertex with the provided key,
+// in no particular order. Stops early if fn returns false, and does nothing if the vertex doesn't exist.
+// Unlike Neighbors it doesn't copy the connections, so fn must not change the graph.
+// For a directed graph, only the connections going out of the vertex are visited
+//
+// Big-O: O(n) because it visits each connection of the vertex once
+func (g *Graph) EachNeighbor(key string, fn func(neighbor string, weight int) bool) {
+	vertex, ok := g.vertices[key]
+	if !ok {
+		return
+	}
+	for neighbor, weight := range vertex.connections {
+		if !fn(neighbor, weight) {
+			return
+		}
+	}
+}
+
+// Degree returns the number of connections of the vertex with the provided key, or 0 if it doesn't exist.
+// For a directed graph, this is the out-degree
+//
+// Big-O: O(1) because it's a map lookup
+func (g *Graph) Degree(key string) int {
+	vertex, ok := g.vertices[key]
+	if !ok {
+		return 0
+	}
+	return vertex.Degree()
+}
+
+// Weight returns the weight of the edge between the two vertices, and whether the edge exists
+//
+// Big-O: O(1) because it's two map lookups
+func (g *Graph) Weight(key1, key2 string) (int, bool) {
+	vertex, ok := g.vertices[key1]
+	if !ok {
+		return 0, false
+	}
+	return vertex.Weight(key2)
+}
+
+// Order returns the number of vertices in the graph
+//
+// Big-O: O(1) because the count is kept up to date by AddVertex
+func (g *Graph) Order() int {
+	return g.count
+}
+
+// Size returns the number of edges in the graph
+//
+// Big-O: O(1) because the count is kept up to date by AddConnection
+func (g *Graph) Size() int {
+	return g.edges
+}
+
+// Validate checks the invariants of the graph. Every connection has to point to a vertex in the graph
+// and, unless the graph is directed, be mirrored by the other vertex with the same weight.
+// The vertex and edge counts have to match what's actually stored.
+//
+// Returns nil if the graph is valid, or an error describing the first problem found.
+//
+// Big-O: O(n^2) because it loops through each vertex and each connection of the vertex
+func (g *Graph) Validate() error {
+	if g.count != len(g.vertices) {
+		return fmt.Errorf("vertex count is %d, but the graph has %d vertices", g.count, len(g.vertices))
+	}
+
+	// Each undirected edge is seen from both ends, except self loops which are only stored once.
+	// Directed edges are only stored once
+	ends := 0
+	loops := 0
+	for key, vertex := range g.vertices {
+		if vertex.key != key {
+			return fmt.Errorf("vertex %q is stored under key %q", vertex.key, key)
+		}
+		for connectionKey, weight := range vertex.connections {
+			other, ok := g.vertices[connectionKey]
+			if !ok {
+				return fmt.Errorf("vertex %q is connected to missing vertex %q", key, connectionKey)
+			}
+			if g.directed {
+				ends++
+				continue
+			}
+			if otherWeight, ok := other.connections[key]; !ok || otherWeight != weight {
+				return fmt.Errorf("connection %q-%q is not symmetric", key, connectionKey)
+			}
+			if connectionKey == key {
+				loops++
+			} else {
+				ends++
+			}
+		}
+	}
+
+	edges := ends/2 + loops
+	if g.directed {
+		edges = ends
+	}
+	if edges != g.edges {
+		return fmt.Errorf("edge count is %d, but the graph has %d edges", g.edges, edges)
+	}
+	return nil
 }
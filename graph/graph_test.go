@@ -4,89 +4,79 @@ import "testing"
 
 func TestGraph_AddVertex(t *testing.T) {
 	// Arrange
-	graph := &Graph{
-		Vertices: make(map[string]*Vertex),
-	}
+	graph := EmptyGraph()
 	// Act
 	graph.AddVertex("AX1")
 	// Assert
-	if graph.Count != 1 {
-		t.Errorf("Expected 1, but got %d", graph.Count)
+	if graph.Order() != 1 {
+		t.Errorf("Expected 1, but got %d", graph.Order())
 	}
-	if len(graph.Vertices) != 1 {
-		t.Errorf("Expected 1, but got %d", len(graph.Vertices))
+	if len(graph.GetKeys()) != 1 {
+		t.Errorf("Expected 1, but got %d", len(graph.GetKeys()))
 	}
 }
 
 func TestGraph_AddVertex_Duplicate(t *testing.T) {
 	// Arrange
-	graph := &Graph{
-		Vertices: make(map[string]*Vertex),
-	}
+	graph := EmptyGraph()
 	// Act
 	graph.AddVertex("AX1")
 	graph.AddVertex("AX1")
 	// Assert
-	if graph.Count != 1 {
-		t.Errorf("Expected 1, but got %d", graph.Count)
+	if graph.Order() != 1 {
+		t.Errorf("Expected 1, but got %d", graph.Order())
 	}
-	if len(graph.Vertices) != 1 {
-		t.Errorf("Expected 1, but got %d", len(graph.Vertices))
+	if len(graph.GetKeys()) != 1 {
+		t.Errorf("Expected 1, but got %d", len(graph.GetKeys()))
 	}
 }
 
 func TestGraph_AddConnection(t *testing.T) {
 	// Arrange
-	graph := &Graph{
-		Vertices: make(map[string]*Vertex),
-	}
+	graph := EmptyGraph()
 	graph.AddVertex("AX1")
 	graph.AddVertex("AX2")
 	// Act
 	graph.AddConnection("AX1", "AX2", 3)
 	// Assert
-	if len(graph.Vertices["AX1"].Connections) != 1 {
-		t.Errorf("Expected 1, but got %d", len(graph.Vertices["AX1"].Connections))
+	if graph.Degree("AX1") != 1 {
+		t.Errorf("Expected 1, but got %d", graph.Degree("AX1"))
 	}
-	if len(graph.Vertices["AX2"].Connections) != 1 {
-		t.Errorf("Expected 1, but got %d", len(graph.Vertices["AX2"].Connections))
+	if graph.Degree("AX2") != 1 {
+		t.Errorf("Expected 1, but got %d", graph.Degree("AX2"))
 	}
 }
 
 func TestGraph_AddConnection_Duplicate(t *testing.T) {
 	// Arrange
-	graph := &Graph{
-		Vertices: make(map[string]*Vertex),
-	}
+	graph := EmptyGraph()
 	graph.AddVertex("AX1")
 	graph.AddVertex("AX2")
 	// Act
 	graph.AddConnection("AX1", "AX2", 3)
 	graph.AddConnection("AX1", "AX2", 3)
 	// Assert
-	if len(graph.Vertices["AX1"].Connections) != 1 {
-		t.Errorf("Expected 1, but got %d", len(graph.Vertices["AX1"].Connections))
+	if graph.Degree("AX1") != 1 {
+		t.Errorf("Expected 1, but got %d", graph.Degree("AX1"))
 	}
-	if len(graph.Vertices["AX2"].Connections) != 1 {
-		t.Errorf("Expected 1, but got %d", len(graph.Vertices["AX2"].Connections))
+	if graph.Degree("AX2") != 1 {
+		t.Errorf("Expected 1, but got %d", graph.Degree("AX2"))
 	}
 }
 
 func TestGraph_AddConnection_AddsWeights(t *testing.T) {
 	// Arrange
-	graph := &Graph{
-		Vertices: make(map[string]*Vertex),
-	}
+	graph := EmptyGraph()
 	graph.AddVertex("AX1")
 	graph.AddVertex("AX2")
 	// Act
 	graph.AddConnection("AX1", "AX2", 3)
 	// Assert
-	if graph.Vertices["AX1"].Connections["AX2"] != 3 {
-		t.Errorf("Expected 3, but got %d", graph.Vertices["AX1"].Connections["AX2"])
+	if graph.Neighbors("AX1")["AX2"] != 3 {
+		t.Errorf("Expected 3, but got %d", graph.Neighbors("AX1")["AX2"])
 	}
-	if graph.Vertices["AX2"].Connections["AX1"] != 3 {
-		t.Errorf("Expected 3, but got %d", graph.Vertices["AX2"].Connections["AX1"])
+	if graph.Neighbors("AX2")["AX1"] != 3 {
+		t.Errorf("Expected 3, but got %d", graph.Neighbors("AX2")["AX1"])
 	}
 
 }
@@ -116,11 +106,11 @@ func TestNewGraph_MakesVertices(t *testing.T) {
 	// Act
 	graph := NewGraph(adjacencyList)
 	// Assert
-	if graph.Count != 5 {
-		t.Errorf("Expected 5, but got %d", graph.Count)
+	if graph.Order() != 5 {
+		t.Errorf("Expected 5, but got %d", graph.Order())
 	}
-	if len(graph.Vertices) != 5 {
-		t.Errorf("Expected 5, but got %d", len(graph.Vertices))
+	if len(graph.GetKeys()) != 5 {
+		t.Errorf("Expected 5, but got %d", len(graph.GetKeys()))
 	}
 }
 
@@ -137,24 +127,24 @@ func TestNewGraph_MakesConnections(t *testing.T) {
 	// Act
 	graph := NewGraph(adjacencyList)
 	// Assert
-	if len(graph.Vertices["AX1"].Connections) != 3 {
-		t.Errorf("Expected 3, but got %d", len(graph.Vertices["AX1"].Connections))
+	if graph.Degree("AX1") != 3 {
+		t.Errorf("Expected 3, but got %d", graph.Degree("AX1"))
 	}
 
-	if len(graph.Vertices["AX2"].Connections) != 3 {
-		t.Errorf("Expected 3, but got %d", len(graph.Vertices["AX2"].Connections))
+	if graph.Degree("AX2") != 3 {
+		t.Errorf("Expected 3, but got %d", graph.Degree("AX2"))
 	}
 
-	if len(graph.Vertices["AX3"].Connections) != 3 {
-		t.Errorf("Expected 3, but got %d", len(graph.Vertices["AX3"].Connections))
+	if graph.Degree("AX3") != 3 {
+		t.Errorf("Expected 3, but got %d", graph.Degree("AX3"))
 	}
 
-	if len(graph.Vertices["AX4"].Connections) != 4 {
-		t.Errorf("Expected 4, but got %d", len(graph.Vertices["AX4"].Connections))
+	if graph.Degree("AX4") != 4 {
+		t.Errorf("Expected 4, but got %d", graph.Degree("AX4"))
 	}
 
-	if len(graph.Vertices["AX5"].Connections) != 1 {
-		t.Errorf("Expected 1, but got %d", len(graph.Vertices["AX5"].Connections))
+	if graph.Degree("AX5") != 1 {
+		t.Errorf("Expected 1, but got %d", graph.Degree("AX5"))
 	}
 }
 
@@ -197,3 +187,223 @@ func TestNewGraph_InvalidWeight(t *testing.T) {
 	}()
 	_ = NewGraph(adjacencyList)
 }
+
+func TestGraph_SizeCountsEdges(t *testing.T) {
+	// Arrange
+	graph := EmptyGraph()
+	graph.AddVertex("AX1")
+	graph.AddVertex("AX2")
+	graph.AddVertex("AX3")
+	// Act
+	graph.AddConnection("AX1", "AX2", 3)
+	graph.AddConnection("AX2", "AX3", 4)
+	graph.AddConnection("AX2", "AX1", 5)
+	// Assert
+	if graph.Size() != 2 {
+		t.Errorf("Expected 2, but got %d", graph.Size())
+	}
+}
+
+func TestGraph_AddConnection_MissingVertexDoesNothing(t *testing.T) {
+	// Arrange
+	graph := EmptyGraph()
+	graph.AddVertex("AX1")
+	// Act
+	graph.AddConnection("AX1", "AX2", 3)
+	// Assert
+	if graph.Degree("AX1") != 0 {
+		t.Errorf("Expected 0, but got %d", graph.Degree("AX1"))
+	}
+	if graph.Size() != 0 {
+		t.Errorf("Expected 0, but got %d", graph.Size())
+	}
+}
+
+func TestGraph_NeighborsReturnsCopy(t *testing.T) {
+	// Arrange
+	graph := EmptyGraph()
+	graph.AddVertex("AX1")
+	graph.AddVertex("AX2")
+	graph.AddConnection("AX1", "AX2", 3)
+	// Act
+	neighbors := graph.Neighbors("AX1")
+	neighbors["AX3"] = 7
+	// Assert
+	if graph.Degree("AX1") != 1 {
+		t.Errorf("Expected 1, but got %d", graph.Degree("AX1"))
+	}
+	if err := graph.Validate(); err != nil {
+		t.Errorf("Expected a valid graph, but got %v", err)
+	}
+}
+
+func TestGraph_NeighborsReturnsNilOnMissingVertex(t *testing.T) {
+	// Arrange
+	graph := EmptyGraph()
+	// Act
+	neighbors := graph.Neighbors("AX1")
+	// Assert
+	if neighbors != nil {
+		t.Errorf("Expected nil, but got %v", neighbors)
+	}
+}
+
+func TestGraph_EachNeighborVisitsEveryConnection(t *testing.T) {
+	// Arrange
+	graph := EmptyGraph()
+	graph.AddVertex("AX1")
+	graph.AddVertex("AX2")
+	graph.AddVertex("AX3")
+	graph.AddConnection("AX1", "AX2", 3)
+	graph.AddConnection("AX1", "AX3", 4)
+	total := 0
+	// Act
+	graph.EachNeighbor("AX1", func(_ string, weight int) bool {
+		total += weight
+		return true
+	})
+	graph.EachNeighbor("AX4", func(string, int) bool {
+		t.Errorf("Expected no neighbors for a missing vertex")
+		return true
+	})
+	// Assert
+	if total != 7 {
+		t.Errorf("Expected 7, but got %d", total)
+	}
+}
+
+func TestGraph_EachNeighborStopsEarly(t *testing.T) {
+	// Arrange
+	graph := EmptyGraph()
+	graph.AddVertex("AX1")
+	graph.AddVertex("AX2")
+	graph.AddVertex("AX3")
+	graph.AddConnection("AX1", "AX2", 3)
+	graph.AddConnection("AX1", "AX3", 4)
+	visited := 0
+	// Act
+	graph.EachNeighbor("AX1", func(string, int) bool {
+		visited++
+		return false
+	})
+	// Assert
+	if visited != 1 {
+		t.Errorf("Expected 1, but got %d", visited)
+	}
+}
+
+func TestGraph_Weight(t *testing.T) {
+	// Arrange
+	graph := EmptyGraph()
+	graph.AddVertex("AX1")
+	graph.AddVertex("AX2")
+	graph.AddConnection("AX1", "AX2", 3)
+	// Act
+	weight, ok := graph.Weight("AX2", "AX1")
+	// Assert
+	if !ok || weight != 3 {
+		t.Errorf("Expected 3, but got %d", weight)
+	}
+}
+
+func TestGraph_ValidateAcceptsSelfLoop(t *testing.T) {
+	// Arrange
+	graph := EmptyGraph()
+	graph.AddVertex("AX1")
+	// Act
+	graph.AddConnection("AX1", "AX1", 3)
+	// Assert
+	if graph.Size() != 1 {
+		t.Errorf("Expected 1, but got %d", graph.Size())
+	}
+	if err := graph.Validate(); err != nil {
+		t.Errorf("Expected a valid graph, but got %v", err)
+	}
+}
+
+func TestGraph_ValidateDetectsOneWayConnection(t *testing.T) {
+	// Arrange
+	graph := EmptyGraph()
+	graph.AddVertex("AX1")
+	graph.AddVertex("AX2")
+	graph.AddConnection("AX1", "AX2", 3)
+	// Act
+	graph.GetVertex("AX1").addConnection("AX2", 4)
+	// Assert
+	if graph.Validate() == nil {
+		t.Errorf("Expected an error, but got nil")
+	}
+}
+
+func TestGraph_ValidateDetectsWrongCount(t *testing.T) {
+	// Arrange
+	graph := EmptyGraph()
+	graph.AddVertex("AX1")
+	// Act
+	graph.count++
+	// Assert
+	if graph.Validate() == nil {
+		t.Errorf("Expected an error, but got nil")
+	}
+}
+
+func TestNewGraph_IsValid(t *testing.T) {
+	// Arrange
+	adjacencyList := []string{
+		"AX1,AX2,AX3,AX4,AX5",
+		"AX1,AX4:3,AX2:3,AX3:6",
+		"AX2,AX1:3,AX3:3,AX4:6",
+		"AX3,AX2:3,AX1:6,AX4:4",
+		"AX4,AX1:3,AX2:6,AX3:4,AX5:15",
+		"AX5,AX4:15",
+	}
+	// Act
+	graph := NewGraph(adjacencyList)
+	// Assert
+	if graph.Size() != 7 {
+		t.Errorf("Expected 7, but got %d", graph.Size())
+	}
+	if err := graph.Validate(); err != nil {
+		t.Errorf("Expected a valid graph, but got %v", err)
+	}
+}
+
+func TestDirectedGraph_AddConnectionIsOneWay(t *testing.T) {
+	// Arrange
+	graph := EmptyDirectedGraph()
+	graph.AddVertex("AX1")
+	graph.AddVertex("AX2")
+	// Act
+	graph.AddConnection("AX1", "AX2", 3)
+	// Assert
+	if graph.Degree("AX1") != 1 {
+		t.Errorf("Expected 1, but got %d", graph.Degree("AX1"))
+	}
+	if graph.Degree("AX2") != 0 {
+		t.Errorf("Expected 0, but got %d", graph.Degree("AX2"))
+	}
+	if graph.Size() != 1 {
+		t.Errorf("Expected 1, but got %d", graph.Size())
+	}
+}
+
+func TestNewDirectedGraph_IsValid(t *testing.T) {
+	// Arrange
+	adjacencyList := []string{
+		"AX1,AX2,AX3",
+		"AX1,AX2:3",
+		"AX2,AX1:4,AX3:5",
+	}
+	// Act
+	graph := NewDirectedGraph(adjacencyList)
+	// Assert
+	if !graph.Directed() {
+		t.Errorf("Expected a directed graph")
+	}
+	if graph.Size() != 3 {
+		t.Errorf("Expected 3, but got %d", graph.Size())
+	}
+	if err := graph.Validate(); err != nil {
+		t.Errorf("Expected a valid graph, but got %v", err)
+	}
+}
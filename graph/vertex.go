@@ -1,8 +1,8 @@
 package graph
 
 type Vertex struct {
-	Key         string
-	Connections map[string]int
+	key         string
+	connections map[string]int
 }
 
 // NewVertex creates a new vertex with the given key.
@@ -10,14 +10,49 @@ type Vertex struct {
 // Big-O: O(1) because it creates a new vertex
 func NewVertex(key string) *Vertex {
 	return &Vertex{
-		Key:         key,
-		Connections: make(map[string]int),
+		key:         key,
+		connections: make(map[string]int),
 	}
 }
 
-// AddConnection adds a connection to the vertex with the given key and weight.
+// Key returns the key of the vertex
+//
+// Big-O: O(1) because it just returns the key
+func (v *Vertex) Key() string {
+	return v.key
+}
+
+// Connections returns a copy of the vertex's connections, mapping each neighbor's key to the weight of the edge.
+// Changing the returned map does not change the graph.
+//
+// Big-O: O(n) because it copies each connection
+func (v *Vertex) Connections() map[string]int {
+	connections := make(map[string]int, len(v.connections))
+	for key, weight := range v.connections {
+		connections[key] = weight
+	}
+	return connections
+}
+
+// Weight returns the weight of the connection to the vertex with the given key, and whether the connection exists
+//
+// Big-O: O(1) because it's a map lookup
+func (v *Vertex) Weight(key string) (int, bool) {
+	weight, ok := v.connections[key]
+	return weight, ok
+}
+
+// Degree returns the number of connections the vertex has
+//
+// Big-O: O(1) because it's the length of the map
+func (v *Vertex) Degree() int {
+	return len(v.connections)
+}
+
+// addConnection adds a connection to the vertex with the given key and weight.
+// It is unexported so that connections can only be made through the graph, which keeps both sides in sync.
 //
 // Big-O: O(1) because it adds a connection to the map
-func (v *Vertex) AddConnection(key string, weight int) {
-	v.Connections[key] = weight
+func (v *Vertex) addConnection(key string, weight int) {
+	v.connections[key] = weight
 }
@@ -0,0 +1,188 @@
+// Package graph_gen generates random graphs from a seeded random number generator,
+// so tests and benchmarks can run algorithms on graphs bigger than a hand-written adjacency list.
+package graph_gen
+
+import (
+	"math/rand"
+	"strconv"
+
+	"github.com/robertjshirts/data-structures/graph"
+)
+
+// Generator creates graphs using its own random number generator, so the same seed always gives the same graphs
+type Generator struct {
+	rng     *rand.Rand
+	weights Weights
+}
+
+// NewGenerator creates a generator with the given seed, and picks edge weights with weights
+func NewGenerator(seed int64, weights Weights) *Generator {
+	return &Generator{
+		rng:     rand.New(rand.NewSource(seed)),
+		weights: weights,
+	}
+}
+
+// Key returns the key of the i-th vertex of a generated graph. Generated graphs use the keys Key(0) to Key(n-1)
+func Key(i int) string {
+	return "V" + strconv.Itoa(i)
+}
+
+// ErdosRenyi creates an Erdős–Rényi G(n, p) graph, where each of the possible edges is added with probability p
+//
+// Panics if n is negative or p isn't between 0 and 1.
+//
+// Big-O: O(n^2) because it tries every pair of vertices
+func (g *Generator) ErdosRenyi(n int, p float64) *graph.Graph {
+	checkProbability(p)
+	result := g.vertices(n)
+	g.addRandomEdges(result, n, p)
+	return result
+}
+
+// Connected creates a random connected graph. It starts from a random spanning tree (see Tree),
+// then adds each of the remaining possible edges with probability p.
+//
+// Panics if n is negative or p isn't between 0 and 1.
+//
+// Big-O: O(n^2) because it tries every pair of vertices
+func (g *Generator) Connected(n int, p float64) *graph.Graph {
+	checkProbability(p)
+	result := g.Tree(n)
+	g.addRandomEdges(result, n, p)
+	return result
+}
+
+// Grid creates a rows by cols grid, where each vertex is connected to the vertices above, below, left and right of it.
+// The vertex in row r and column c has the key Key(r*cols + c)
+//
+// Panics if rows or cols is negative.
+//
+// Big-O: O(rows*cols) because each vertex gets at most two new edges
+func (g *Generator) Grid(rows, cols int) *graph.Graph {
+	if rows < 0 || cols < 0 {
+		panic("Grid size is negative")
+	}
+	result := g.vertices(rows * cols)
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			if c+1 < cols {
+				result.AddConnection(Key(r*cols+c), Key(r*cols+c+1), g.weights(g.rng))
+			}
+			if r+1 < rows {
+				result.AddConnection(Key(r*cols+c), Key((r+1)*cols+c), g.weights(g.rng))
+			}
+		}
+	}
+	return result
+}
+
+// Complete creates a complete graph, where every vertex is connected to every other vertex
+//
+// Panics if n is negative.
+//
+// Big-O: O(n^2) because it adds every possible edge
+func (g *Generator) Complete(n int) *graph.Graph {
+	result := g.vertices(n)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			result.AddConnection(Key(i), Key(j), g.weights(g.rng))
+		}
+	}
+	return result
+}
+
+// Tree creates a random tree. The vertices are added in a random order,
+// and each one is connected to a random vertex that was added before it.
+//
+// Panics if n is negative.
+//
+// Big-O: O(n) because each vertex gets one edge
+func (g *Generator) Tree(n int) *graph.Graph {
+	result := g.vertices(n)
+	order := g.rng.Perm(n)
+	for i := 1; i < n; i++ {
+		parent := order[g.rng.Intn(i)]
+		result.AddConnection(Key(order[i]), Key(parent), g.weights(g.rng))
+	}
+	return result
+}
+
+// BarabasiAlbert creates a Barabási–Albert preferential attachment graph. It starts with a complete graph
+// of m+1 vertices, then each new vertex connects to m different existing vertices, picked with a probability
+// proportional to their degree.
+//
+// Panics if m is less than 1 or n is less than m+1.
+//
+// Big-O: O(n*m) because each new vertex gets m edges
+func (g *Generator) BarabasiAlbert(n, m int) *graph.Graph {
+	if m < 1 {
+		panic("m must be at least 1")
+	}
+	if n < m+1 {
+		panic("n must be at least m+1")
+	}
+
+	result := g.Complete(m + 1)
+
+	// Each vertex shows up in ends once per edge, so picking from it is proportional to degree
+	ends := make([]int, 0, 2*(m*(m+1)/2+(n-m-1)*m))
+	for i := 0; i <= m; i++ {
+		for j := 0; j < m; j++ {
+			ends = append(ends, i)
+		}
+	}
+
+	for i := m + 1; i < n; i++ {
+		result.AddVertex(Key(i))
+
+		// Pick m different targets
+		targets := make(map[int]bool, m)
+		picked := make([]int, 0, m)
+		for len(picked) < m {
+			target := ends[g.rng.Intn(len(ends))]
+			if !targets[target] {
+				targets[target] = true
+				picked = append(picked, target)
+			}
+		}
+
+		for _, target := range picked {
+			result.AddConnection(Key(i), Key(target), g.weights(g.rng))
+			ends = append(ends, i, target)
+		}
+	}
+	return result
+}
+
+// vertices creates a graph with the vertices Key(0) to Key(n-1) and no edges
+func (g *Generator) vertices(n int) *graph.Graph {
+	if n < 0 {
+		panic("Vertex count is negative")
+	}
+	result := graph.EmptyGraph()
+	for i := 0; i < n; i++ {
+		result.AddVertex(Key(i))
+	}
+	return result
+}
+
+// addRandomEdges adds each missing edge between the first n vertices with probability p
+func (g *Generator) addRandomEdges(result *graph.Graph, n int, p float64) {
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if g.rng.Float64() < p {
+				if _, ok := result.Weight(Key(i), Key(j)); !ok {
+					result.AddConnection(Key(i), Key(j), g.weights(g.rng))
+				}
+			}
+		}
+	}
+}
+
+func checkProbability(p float64) {
+	// Written so NaN fails too, since every comparison with NaN is false
+	if !(p >= 0 && p <= 1) {
+		panic("Probability must be between 0 and 1")
+	}
+}
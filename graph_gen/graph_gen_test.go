@@ -0,0 +1,228 @@
+package graph_gen
+
+import (
+	"math"
+	"testing"
+
+	"github.com/robertjshirts/data-structures/graph"
+)
+
+func TestErdosRenyi_ZeroProbabilityHasNoEdges(t *testing.T) {
+	// Arrange
+	gen := NewGenerator(1, Constant(1))
+	// Act
+	g := gen.ErdosRenyi(20, 0)
+	// Assert
+	assertCounts(t, g, 20, 0)
+}
+
+func TestErdosRenyi_FullProbabilityIsComplete(t *testing.T) {
+	// Arrange
+	gen := NewGenerator(1, Constant(1))
+	// Act
+	g := gen.ErdosRenyi(20, 1)
+	// Assert
+	assertCounts(t, g, 20, 190)
+}
+
+func TestErdosRenyi_SameSeedSameGraph(t *testing.T) {
+	// Arrange
+	first := NewGenerator(42, Uniform(1, 100))
+	second := NewGenerator(42, Uniform(1, 100))
+	// Act
+	a := first.ErdosRenyi(30, 0.3)
+	b := second.ErdosRenyi(30, 0.3)
+	// Assert
+	for i := 0; i < 30; i++ {
+		for key, weight := range a.Neighbors(Key(i)) {
+			if other, ok := b.Weight(Key(i), key); !ok || other != weight {
+				t.Errorf("Expected edge %v-%v with weight %v in both graphs", Key(i), key, weight)
+			}
+		}
+	}
+	if a.Size() != b.Size() {
+		t.Errorf("Expected %v edges, got %v", a.Size(), b.Size())
+	}
+}
+
+func TestErdosRenyi_PanicsOnInvalidProbability(t *testing.T) {
+	// Arrange
+	gen := NewGenerator(1, Constant(1))
+	defer func() {
+		// Assert
+		if r := recover(); r == nil {
+			t.Errorf("The code did not panic")
+		}
+	}()
+	// Act
+	gen.ErdosRenyi(5, 1.5)
+}
+
+func TestErdosRenyi_PanicsOnNaNProbability(t *testing.T) {
+	// Arrange
+	gen := NewGenerator(1, Constant(1))
+	defer func() {
+		// Assert
+		if r := recover(); r == nil {
+			t.Errorf("The code did not panic")
+		}
+	}()
+	// Act
+	gen.ErdosRenyi(5, math.NaN())
+}
+
+func TestConnected_IsConnected(t *testing.T) {
+	// Arrange
+	gen := NewGenerator(7, Uniform(1, 10))
+	for i := 0; i < 20; i++ {
+		// Act
+		g := gen.Connected(50, 0.02)
+		// Assert
+		assertValid(t, g)
+		if !isConnected(g) {
+			t.Fatalf("Expected a connected graph")
+		}
+	}
+}
+
+func TestGrid_HasGridEdges(t *testing.T) {
+	// Arrange
+	gen := NewGenerator(1, Constant(1))
+	// Act
+	g := gen.Grid(4, 6)
+	// Assert
+	assertCounts(t, g, 24, 4*5+6*3)
+	if g.Degree(Key(0)) != 2 {
+		t.Errorf("Expected corner to have degree 2, got %v", g.Degree(Key(0)))
+	}
+	if g.Degree(Key(7)) != 4 {
+		t.Errorf("Expected inner vertex to have degree 4, got %v", g.Degree(Key(7)))
+	}
+}
+
+func TestComplete_HasAllEdges(t *testing.T) {
+	// Arrange
+	gen := NewGenerator(1, Constant(1))
+	// Act
+	g := gen.Complete(10)
+	// Assert
+	assertCounts(t, g, 10, 45)
+}
+
+func TestTree_IsSpanningTree(t *testing.T) {
+	// Arrange
+	gen := NewGenerator(3, Uniform(1, 10))
+	// Act
+	g := gen.Tree(100)
+	// Assert
+	assertCounts(t, g, 100, 99)
+	if !isConnected(g) {
+		t.Errorf("Expected a connected graph")
+	}
+}
+
+func TestTree_EmptyTree(t *testing.T) {
+	// Arrange
+	gen := NewGenerator(3, Uniform(1, 10))
+	// Act
+	g := gen.Tree(0)
+	// Assert
+	assertCounts(t, g, 0, 0)
+}
+
+func TestBarabasiAlbert_HasExpectedEdges(t *testing.T) {
+	// Arrange
+	gen := NewGenerator(5, Constant(1))
+	n, m := 100, 3
+	// Act
+	g := gen.BarabasiAlbert(n, m)
+	// Assert
+	assertCounts(t, g, n, m*(m+1)/2+(n-m-1)*m)
+	for _, key := range g.GetKeys() {
+		if g.Degree(key) < m {
+			t.Errorf("Expected %v to have degree of at least %v, got %v", key, m, g.Degree(key))
+		}
+	}
+}
+
+func TestBarabasiAlbert_PanicsOnTooFewVertices(t *testing.T) {
+	// Arrange
+	gen := NewGenerator(5, Constant(1))
+	defer func() {
+		// Assert
+		if r := recover(); r == nil {
+			t.Errorf("The code did not panic")
+		}
+	}()
+	// Act
+	gen.BarabasiAlbert(3, 3)
+}
+
+func TestUniform_StaysInRange(t *testing.T) {
+	// Arrange
+	gen := NewGenerator(9, Uniform(5, 8))
+	// Act
+	g := gen.Complete(30)
+	// Assert
+	for _, key := range g.GetKeys() {
+		for _, weight := range g.Neighbors(key) {
+			if weight < 5 || weight > 8 {
+				t.Errorf("Expected weight between 5 and 8, got %v", weight)
+			}
+		}
+	}
+}
+
+func TestNormalAndExponential_ArePositive(t *testing.T) {
+	// Arrange
+	for _, weights := range []Weights{Normal(2, 5), Exponential(3)} {
+		gen := NewGenerator(11, weights)
+		// Act
+		g := gen.Complete(30)
+		// Assert
+		for _, key := range g.GetKeys() {
+			for _, weight := range g.Neighbors(key) {
+				if weight < 1 {
+					t.Errorf("Expected a positive weight, got %v", weight)
+				}
+			}
+		}
+	}
+}
+
+func assertCounts(t *testing.T, g *graph.Graph, order, size int) {
+	t.Helper()
+	assertValid(t, g)
+	if g.Order() != order {
+		t.Errorf("Expected %v vertices, got %v", order, g.Order())
+	}
+	if g.Size() != size {
+		t.Errorf("Expected %v edges, got %v", size, g.Size())
+	}
+}
+
+func assertValid(t *testing.T, g *graph.Graph) {
+	t.Helper()
+	if err := g.Validate(); err != nil {
+		t.Errorf("Expected a valid graph, got %v", err)
+	}
+}
+
+func isConnected(g *graph.Graph) bool {
+	if g.Order() == 0 {
+		return true
+	}
+	seen := map[string]bool{Key(0): true}
+	stack := []string{Key(0)}
+	for len(stack) > 0 {
+		key := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for neighbor := range g.Neighbors(key) {
+			if !seen[neighbor] {
+				seen[neighbor] = true
+				stack = append(stack, neighbor)
+			}
+		}
+	}
+	return len(seen) == g.Order()
+}
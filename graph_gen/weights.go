@@ -0,0 +1,46 @@
+package graph_gen
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Weights picks the weight of the next edge a generator adds
+type Weights func(rng *rand.Rand) int
+
+// Constant gives every edge the same weight
+func Constant(weight int) Weights {
+	return func(rng *rand.Rand) int {
+		return weight
+	}
+}
+
+// Uniform picks weights uniformly from min to max, inclusive
+//
+// Panics if max is less than min
+func Uniform(min, max int) Weights {
+	if max < min {
+		panic("Max is less than min")
+	}
+	return func(rng *rand.Rand) int {
+		return min + rng.Intn(max-min+1)
+	}
+}
+
+// Normal picks weights from a normal distribution, rounded to the nearest int.
+// Weights are clamped to be at least 1, so the graph can still be used by algorithms that need positive weights
+func Normal(mean, stddev float64) Weights {
+	return func(rng *rand.Rand) int {
+		weight := int(math.Round(rng.NormFloat64()*stddev + mean))
+		return max(weight, 1)
+	}
+}
+
+// Exponential picks weights from an exponential distribution with the given mean, rounded up.
+// Weights are clamped to be at least 1, like Normal
+func Exponential(mean float64) Weights {
+	return func(rng *rand.Rand) int {
+		weight := int(math.Ceil(rng.ExpFloat64() * mean))
+		return max(weight, 1)
+	}
+}
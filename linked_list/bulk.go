@@ -0,0 +1,306 @@
+package linked_list
+
+import "fmt"
+
+// Concat moves every node of other to the end of the list, leaving other empty
+//
+// # Panics if other is the same list
+//
+// Big-O is O(1) because we just link our tail to other's head
+func (s *SingleLinkedList[T]) Concat(other *SingleLinkedList[T]) {
+	if other == s {
+		panic("Can't concat a list to itself")
+	}
+	if other.Count == 0 {
+		return
+	}
+
+	if s.Count == 0 {
+		s.Head = other.Head
+	} else {
+		s.Tail.Next = other.Head
+	}
+	s.Tail = other.Tail
+	s.Count += other.Count
+	other.Clear()
+}
+
+// SpliceAt moves every node of other into the list, so that other's head ends up at the specified index.
+// Other is left empty.
+//
+// # Panics if the index is out of bounds, or if other is the same list
+//
+// Big-O is O(n) because s.GetNode is O(n), the splice itself is just reassigning pointers
+func (s *SingleLinkedList[T]) SpliceAt(index int, other *SingleLinkedList[T]) {
+	if other == s {
+		panic("Can't splice a list into itself")
+	}
+	if index < 0 || index > s.Count {
+		panic("Index out of bounds!")
+	}
+	if other.Count == 0 {
+		return
+	}
+
+	// Splicing at the end is the same as concat
+	if index == s.Count {
+		s.Concat(other)
+		return
+	}
+
+	if index == 0 {
+		other.Tail.Next = s.Head
+		s.Head = other.Head
+	} else {
+		prevNode := s.GetNode(index - 1)
+		other.Tail.Next = prevNode.Next
+		prevNode.Next = other.Head
+	}
+	s.Count += other.Count
+	other.Clear()
+}
+
+// SplitAt splits the list in two. Left gets the nodes before the specified index, right gets the rest.
+// The nodes are moved, not copied, so the list is left empty.
+//
+// # Panics if the index is out of bounds
+//
+// Big-O is O(n) because s.GetNode is O(n), the split itself is just reassigning pointers
+func (s *SingleLinkedList[T]) SplitAt(index int) (left, right SingleLinkedList[T]) {
+	if index < 0 || index > s.Count {
+		panic("Index out of bounds!")
+	}
+
+	switch index {
+	case 0:
+		right = *s
+	case s.Count:
+		left = *s
+	default:
+		lastLeft := s.GetNode(index - 1)
+		left = SingleLinkedList[T]{Head: s.Head, Tail: lastLeft, Count: index}
+		right = SingleLinkedList[T]{Head: lastLeft.Next, Tail: s.Tail, Count: s.Count - index}
+		lastLeft.Next = nil
+	}
+
+	s.Clear()
+	return left, right
+}
+
+// Reverse reverses the order of the list in place
+//
+// Big-O is O(n) because every node's pointer has to be flipped
+func (s *SingleLinkedList[T]) Reverse() {
+	var prevNode *node[T]
+	currentNode := s.Head
+	for currentNode != nil {
+		nextNode := currentNode.Next
+		currentNode.Next = prevNode
+		prevNode = currentNode
+		currentNode = nextNode
+	}
+	s.Head, s.Tail = s.Tail, s.Head
+}
+
+// Rotate moves the first k nodes to the end of the list, in order. A negative k moves the last -k nodes to the start.
+// k can be bigger than the list, it wraps around.
+//
+// Big-O is O(n) because we have to find the node that becomes the new tail
+func (s *SingleLinkedList[T]) Rotate(k int) {
+	if s.Count == 0 {
+		return
+	}
+	k = ((k % s.Count) + s.Count) % s.Count
+	if k == 0 {
+		return
+	}
+
+	// Close the loop, then cut it after the new tail
+	newTail := s.GetNode(k - 1)
+	s.Tail.Next = s.Head
+	s.Head = newTail.Next
+	s.Tail = newTail
+	newTail.Next = nil
+}
+
+// Validate checks that the list's Head, Tail and Count agree with its nodes
+//
+// # Returns nil if the list is valid, or an error describing the first problem found
+//
+// Big-O is O(n) because it walks the whole list
+func (s *SingleLinkedList[T]) Validate() error {
+	count := 0
+	var lastNode *node[T]
+	for currentNode := s.Head; currentNode != nil; currentNode = currentNode.Next {
+		count++
+		lastNode = currentNode
+		// A cycle would make the list go on forever, so stop once we've gone past Count
+		if count > s.Count {
+			return fmt.Errorf("list has more than %d nodes", s.Count)
+		}
+	}
+
+	if count != s.Count {
+		return fmt.Errorf("count is %d, but the list has %d nodes", s.Count, count)
+	}
+	if lastNode != s.Tail {
+		return fmt.Errorf("tail is not the last node")
+	}
+	return nil
+}
+
+// Concat moves every node of other to the end of the list, leaving other empty
+//
+// Panics if other is the same list.
+//
+// Big-O is O(1) because we just link our tail to other's head
+func (s *DoubleLinkedList[T]) Concat(other *DoubleLinkedList[T]) {
+	if other == s {
+		panic("Can't concat a list to itself")
+	}
+	if other.Count == 0 {
+		return
+	}
+
+	if s.Count == 0 {
+		s.Head = other.Head
+	} else {
+		s.Tail.Next = other.Head
+		other.Head.Prev = s.Tail
+	}
+	s.Tail = other.Tail
+	s.Count += other.Count
+	other.Clear()
+}
+
+// SpliceAt moves every node of other into the list, so that other's head ends up at the specified index.
+// Other is left empty.
+//
+// Panics if the index is out of bounds, or if other is the same list.
+//
+// Big-O is O(n) because s.GetNode is O(n), the splice itself is just reassigning pointers
+func (s *DoubleLinkedList[T]) SpliceAt(index int, other *DoubleLinkedList[T]) {
+	if other == s {
+		panic("Can't splice a list into itself")
+	}
+	if index < 0 || index > s.Count {
+		panic("Index out of bounds")
+	}
+	if other.Count == 0 {
+		return
+	}
+
+	// Splicing at the end is the same as concat
+	if index == s.Count {
+		s.Concat(other)
+		return
+	}
+
+	// Put other's nodes right before the node at index
+	nextNode := s.GetNode(index)
+	other.Head.Prev = nextNode.Prev
+	if nextNode.Prev == nil {
+		s.Head = other.Head
+	} else {
+		nextNode.Prev.Next = other.Head
+	}
+	other.Tail.Next = nextNode
+	nextNode.Prev = other.Tail
+
+	s.Count += other.Count
+	other.Clear()
+}
+
+// SplitAt splits the list in two. Left gets the nodes before the specified index, right gets the rest.
+// The nodes are moved, not copied, so the list is left empty.
+//
+// Panics if the index is out of bounds.
+//
+// Big-O is O(n) because s.GetNode is O(n), the split itself is just reassigning pointers
+func (s *DoubleLinkedList[T]) SplitAt(index int) (left, right DoubleLinkedList[T]) {
+	if index < 0 || index > s.Count {
+		panic("Index out of bounds")
+	}
+
+	switch index {
+	case 0:
+		right = *s
+	case s.Count:
+		left = *s
+	default:
+		firstRight := s.GetNode(index)
+		left = DoubleLinkedList[T]{Head: s.Head, Tail: firstRight.Prev, Count: index}
+		right = DoubleLinkedList[T]{Head: firstRight, Tail: s.Tail, Count: s.Count - index}
+		firstRight.Prev.Next = nil
+		firstRight.Prev = nil
+	}
+
+	s.Clear()
+	return left, right
+}
+
+// Reverse reverses the order of the list in place
+//
+// Big-O is O(n) because every node's pointers have to be swapped
+func (s *DoubleLinkedList[T]) Reverse() {
+	for currentNode := s.Head; currentNode != nil; currentNode = currentNode.Prev {
+		currentNode.Next, currentNode.Prev = currentNode.Prev, currentNode.Next
+	}
+	s.Head, s.Tail = s.Tail, s.Head
+}
+
+// Rotate moves the first k nodes to the end of the list, in order. A negative k moves the last -k nodes to the start.
+// k can be bigger than the list, it wraps around.
+//
+// Big-O is O(n) because we have to find the node that becomes the new head, but only from the closer end
+func (s *DoubleLinkedList[T]) Rotate(k int) {
+	if s.Count == 0 {
+		return
+	}
+	k = ((k % s.Count) + s.Count) % s.Count
+	if k == 0 {
+		return
+	}
+
+	// Close the loop, then cut it before the new head
+	newHead := s.GetNode(k)
+	s.Tail.Next = s.Head
+	s.Head.Prev = s.Tail
+	s.Tail = newHead.Prev
+	s.Head = newHead
+	s.Tail.Next = nil
+	s.Head.Prev = nil
+}
+
+// Validate checks that the list's Head, Tail and Count agree with its nodes, and that every Prev pointer matches a Next pointer
+//
+// Returns nil if the list is valid, or an error describing the first problem found.
+//
+// Big-O is O(n) because it walks the whole list
+func (s *DoubleLinkedList[T]) Validate() error {
+	if s.Head != nil && s.Head.Prev != nil {
+		return fmt.Errorf("head has a previous node")
+	}
+
+	count := 0
+	var lastNode *doubleNode[T]
+	for currentNode := s.Head; currentNode != nil; currentNode = currentNode.Next {
+		if currentNode.Prev != lastNode {
+			return fmt.Errorf("node %d has the wrong previous node", count)
+		}
+		count++
+		lastNode = currentNode
+		// A cycle would make the list go on forever, so stop once we've gone past Count
+		if count > s.Count {
+			return fmt.Errorf("list has more than %d nodes", s.Count)
+		}
+	}
+
+	if count != s.Count {
+		return fmt.Errorf("count is %d, but the list has %d nodes", s.Count, count)
+	}
+	if lastNode != s.Tail {
+		return fmt.Errorf("tail is not the last node")
+	}
+	return nil
+}
@@ -0,0 +1,276 @@
+package linked_list
+
+import (
+	"github.com/robertjshirts/data-structures/util"
+	"testing"
+)
+
+func singleOf(values ...int) SingleLinkedList[int] {
+	list := EmptySingleLinkedList[int]()
+	for _, value := range values {
+		list.Add(value)
+	}
+	return list
+}
+
+func doubleOf(values ...int) DoubleLinkedList[int] {
+	list := EmptyDoubleLinkedList[int]()
+	for _, value := range values {
+		list.Add(value)
+	}
+	return list
+}
+
+func assertSingle(t *testing.T, list *SingleLinkedList[int], expected string) {
+	t.Helper()
+	if err := list.Validate(); err != nil {
+		t.Errorf("Expected a valid list, got %v", err)
+	}
+	util.SimpleAssert(t, list.ToString(), expected)
+}
+
+func assertDouble(t *testing.T, list *DoubleLinkedList[int], expected string) {
+	t.Helper()
+	if err := list.Validate(); err != nil {
+		t.Errorf("Expected a valid list, got %v", err)
+	}
+	util.SimpleAssert(t, list.ToString(), expected)
+}
+
+func TestSingleLinkedList_Concat(t *testing.T) {
+	// Arrange
+	list := singleOf(1, 2)
+	other := singleOf(3, 4)
+	// Act
+	list.Concat(&other)
+	// Assert
+	assertSingle(t, &list, "1 2 3 4 \n")
+	assertSingle(t, &other, "\n")
+	util.SimpleAssert(t, other.Count, 0)
+}
+
+func TestSingleLinkedList_ConcatOntoEmptyList(t *testing.T) {
+	// Arrange
+	list := EmptySingleLinkedList[int]()
+	other := singleOf(3, 4)
+	// Act
+	list.Concat(&other)
+	list.Add(5)
+	// Assert
+	assertSingle(t, &list, "3 4 5 \n")
+}
+
+func TestSingleLinkedList_ConcatPanicsOnSelf(t *testing.T) {
+	// Arrange
+	list := singleOf(1)
+	defer func() {
+		// Assert
+		if r := recover(); r == nil {
+			t.Errorf("The code did not panic")
+		}
+	}()
+	// Act
+	list.Concat(&list)
+}
+
+func TestSingleLinkedList_SpliceAt(t *testing.T) {
+	// Arrange
+	list := singleOf(1, 4)
+	other := singleOf(2, 3)
+	// Act
+	list.SpliceAt(1, &other)
+	// Assert
+	assertSingle(t, &list, "1 2 3 4 \n")
+	util.SimpleAssert(t, other.Count, 0)
+}
+
+func TestSingleLinkedList_SpliceAtEnds(t *testing.T) {
+	// Arrange
+	list := singleOf(2)
+	front := singleOf(1)
+	back := singleOf(3)
+	// Act
+	list.SpliceAt(0, &front)
+	list.SpliceAt(2, &back)
+	// Assert
+	assertSingle(t, &list, "1 2 3 \n")
+}
+
+func TestSingleLinkedList_SplitAt(t *testing.T) {
+	// Arrange
+	list := singleOf(1, 2, 3, 4)
+	// Act
+	left, right := list.SplitAt(1)
+	// Assert
+	assertSingle(t, &left, "1 \n")
+	assertSingle(t, &right, "2 3 4 \n")
+	assertSingle(t, &list, "\n")
+}
+
+func TestSingleLinkedList_SplitAtEnds(t *testing.T) {
+	// Arrange
+	list := singleOf(1, 2)
+	// Act
+	left, right := list.SplitAt(2)
+	emptyLeft, all := left.SplitAt(0)
+	// Assert
+	assertSingle(t, &right, "\n")
+	assertSingle(t, &emptyLeft, "\n")
+	assertSingle(t, &all, "1 2 \n")
+}
+
+func TestSingleLinkedList_Reverse(t *testing.T) {
+	// Arrange
+	list := singleOf(1, 2, 3)
+	// Act
+	list.Reverse()
+	list.Add(0)
+	// Assert
+	assertSingle(t, &list, "3 2 1 0 \n")
+}
+
+func TestSingleLinkedList_Rotate(t *testing.T) {
+	// Arrange
+	list := singleOf(1, 2, 3, 4, 5)
+	// Act
+	list.Rotate(2)
+	// Assert
+	assertSingle(t, &list, "3 4 5 1 2 \n")
+}
+
+func TestSingleLinkedList_RotateNegativeAndWrapping(t *testing.T) {
+	// Arrange
+	list := singleOf(1, 2, 3, 4, 5)
+	// Act
+	list.Rotate(-1)
+	list.Rotate(10)
+	// Assert
+	assertSingle(t, &list, "5 1 2 3 4 \n")
+}
+
+func TestSingleLinkedList_ValidateDetectsWrongCount(t *testing.T) {
+	// Arrange
+	list := singleOf(1, 2)
+	// Act
+	list.Count = 3
+	// Assert
+	if list.Validate() == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+}
+
+func TestDoubleLinkedList_Concat(t *testing.T) {
+	// Arrange
+	list := doubleOf(1, 2)
+	other := doubleOf(3, 4)
+	// Act
+	list.Concat(&other)
+	// Assert
+	assertDouble(t, &list, "1 2 3 4")
+	assertDouble(t, &other, "")
+}
+
+func TestDoubleLinkedList_ConcatOntoEmptyList(t *testing.T) {
+	// Arrange
+	list := EmptyDoubleLinkedList[int]()
+	other := doubleOf(3, 4)
+	// Act
+	list.Concat(&other)
+	// Assert
+	assertDouble(t, &list, "3 4")
+}
+
+func TestDoubleLinkedList_SpliceAt(t *testing.T) {
+	// Arrange
+	list := doubleOf(1, 4)
+	other := doubleOf(2, 3)
+	// Act
+	list.SpliceAt(1, &other)
+	// Assert
+	assertDouble(t, &list, "1 2 3 4")
+	util.SimpleAssert(t, other.Count, 0)
+}
+
+func TestDoubleLinkedList_SpliceAtEnds(t *testing.T) {
+	// Arrange
+	list := doubleOf(2)
+	front := doubleOf(0, 1)
+	back := doubleOf(3)
+	// Act
+	list.SpliceAt(0, &front)
+	list.SpliceAt(3, &back)
+	// Assert
+	assertDouble(t, &list, "0 1 2 3")
+}
+
+func TestDoubleLinkedList_SpliceAtPanicsOnInvalidIndex(t *testing.T) {
+	// Arrange
+	list := doubleOf(1)
+	other := doubleOf(2)
+	defer func() {
+		// Assert
+		if r := recover(); r == nil {
+			t.Errorf("The code did not panic")
+		}
+	}()
+	// Act
+	list.SpliceAt(2, &other)
+}
+
+func TestDoubleLinkedList_SplitAt(t *testing.T) {
+	// Arrange
+	list := doubleOf(1, 2, 3, 4)
+	// Act
+	left, right := list.SplitAt(3)
+	// Assert
+	assertDouble(t, &left, "1 2 3")
+	assertDouble(t, &right, "4")
+	assertDouble(t, &list, "")
+}
+
+func TestDoubleLinkedList_Reverse(t *testing.T) {
+	// Arrange
+	list := doubleOf(1, 2, 3)
+	// Act
+	list.Reverse()
+	// Assert
+	assertDouble(t, &list, "3 2 1")
+}
+
+func TestDoubleLinkedList_ReverseEmptyList(t *testing.T) {
+	// Arrange
+	list := EmptyDoubleLinkedList[int]()
+	// Act
+	list.Reverse()
+	// Assert
+	assertDouble(t, &list, "")
+}
+
+func TestDoubleLinkedList_Rotate(t *testing.T) {
+	// Arrange
+	list := doubleOf(1, 2, 3, 4, 5)
+	// Act
+	list.Rotate(4)
+	// Assert
+	assertDouble(t, &list, "5 1 2 3 4")
+}
+
+func TestDoubleLinkedList_RotateNegative(t *testing.T) {
+	// Arrange
+	list := doubleOf(1, 2, 3, 4, 5)
+	// Act
+	list.Rotate(-2)
+	// Assert
+	assertDouble(t, &list, "4 5 1 2 3")
+}
+
+func TestDoubleLinkedList_ValidateDetectsBrokenPrev(t *testing.T) {
+	// Arrange
+	list := doubleOf(1, 2, 3)
+	// Act
+	list.Tail.Prev = list.Head
+	// Assert
+	if list.Validate() == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+}
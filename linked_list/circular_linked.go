@@ -0,0 +1,248 @@
+package linked_list
+
+import "fmt"
+
+// CircularLinkedList is a ring of doubly linked nodes with no head or tail, just a current node.
+// The last node links back to the first, so Advance never runs off the end, which makes it a good fit for round-robin scheduling.
+type CircularLinkedList[T any] struct {
+	current *doubleNode[T]
+	Count   int
+}
+
+// EmptyCircularLinkedList creates an empty ring
+//
+// Returns a ring with no current node and a count of 0.
+//
+// Big-O is O(1) because we're just instantiating the struct
+func EmptyCircularLinkedList[T any]() CircularLinkedList[T] {
+	return CircularLinkedList[T]{
+		current: nil,
+		Count:   0,
+	}
+}
+
+// NewCircularLinkedList creates a ring with a first node, which links to itself
+//
+// Returns a ring whose current node has the value of firstItem.
+//
+// Big-O is O(1) because we're just instantiating a new node
+func NewCircularLinkedList[T any](firstItem T) CircularLinkedList[T] {
+	list := EmptyCircularLinkedList[T]()
+	list.InsertAfterCurrent(firstItem)
+	return list
+}
+
+// Current returns the value of the current node
+//
+// Panics if the ring is empty.
+//
+// Big-O is O(1) because we keep a pointer to the current node
+func (s *CircularLinkedList[T]) Current() T {
+	if s.current == nil {
+		panic("List is empty")
+	}
+	return s.current.Value
+}
+
+// Advance moves the current node forward by one, wrapping around from the last node to the first.
+// Does nothing if the ring is empty.
+//
+// Big-O is O(1) because we just follow the next pointer
+func (s *CircularLinkedList[T]) Advance() {
+	if s.current != nil {
+		s.current = s.current.Next
+	}
+}
+
+// Retreat moves the current node back by one, the opposite of Advance.
+// Does nothing if the ring is empty.
+//
+// Big-O is O(1) because we just follow the previous pointer
+func (s *CircularLinkedList[T]) Retreat() {
+	if s.current != nil {
+		s.current = s.current.Prev
+	}
+}
+
+// InsertAfterCurrent inserts a value right after the current node, so it's reached by the next Advance.
+// The current node doesn't change, unless the ring was empty, in which case the new node becomes current.
+//
+// Big-O is O(1) because we just relink the current node and its next node
+func (s *CircularLinkedList[T]) InsertAfterCurrent(value T) {
+	newNode := &doubleNode[T]{Value: value}
+	if s.current == nil {
+		newNode.Next = newNode
+		newNode.Prev = newNode
+		s.current = newNode
+	} else {
+		linkAfter(s.current, newNode)
+	}
+	s.Count++
+}
+
+// InsertBeforeCurrent inserts a value right before the current node, so it's reached last when advancing around the ring.
+// The current node doesn't change, unless the ring was empty, in which case the new node becomes current.
+//
+// Big-O is O(1) because we just relink the current node and its previous node
+func (s *CircularLinkedList[T]) InsertBeforeCurrent(value T) {
+	if s.current == nil {
+		s.InsertAfterCurrent(value)
+		return
+	}
+	linkAfter(s.current.Prev, &doubleNode[T]{Value: value})
+	s.Count++
+}
+
+// RemoveCurrent removes the current node and returns its value. The next node becomes current.
+//
+// Panics if the ring is empty.
+//
+// Big-O is O(1) because we just relink the current node's neighbors
+func (s *CircularLinkedList[T]) RemoveCurrent() T {
+	if s.current == nil {
+		panic("List is empty")
+	}
+
+	removed := s.current
+	if s.Count == 1 {
+		s.current = nil
+	} else {
+		removed.Prev.Next = removed.Next
+		removed.Next.Prev = removed.Prev
+		s.current = removed.Next
+	}
+	removed.Next = nil
+	removed.Prev = nil
+	s.Count--
+	return removed.Value
+}
+
+// Clear removes every node from the ring
+//
+// Big-O is O(1) because we just drop the current node and let the garbage collector take the rest
+func (s *CircularLinkedList[T]) Clear() {
+	s.current = nil
+	s.Count = 0
+}
+
+// Each calls fn with the index and value of each node, going forward once around the ring from the current node.
+// The current node has index 0. Stops early if fn returns false.
+//
+// Big-O is O(n) because it will have to iterate through the ring
+func (s *CircularLinkedList[T]) Each(fn func(index int, value T) bool) {
+	currentNode := s.current
+	for i := 0; i < s.Count; i++ {
+		if !fn(i, currentNode.Value) {
+			return
+		}
+		currentNode = currentNode.Next
+	}
+}
+
+// EachReverse calls fn with the index and value of each node, going backward once around the ring.
+// Indexes match Each, so it starts at the node before current with index Count - 1, and ends at the current node with index 0.
+// Stops early if fn returns false.
+//
+// Big-O is O(n) because it will have to iterate through the ring
+func (s *CircularLinkedList[T]) EachReverse(fn func(index int, value T) bool) {
+	if s.current == nil {
+		return
+	}
+	currentNode := s.current.Prev
+	for i := s.Count - 1; i >= 0; i-- {
+		if !fn(i, currentNode.Value) {
+			return
+		}
+		currentNode = currentNode.Prev
+	}
+}
+
+// ToString converts the ring to a string, starting from the current node
+//
+// Returns a string representation of the ring in the following format: "1 2 3 4".
+// Will return an empty string if the ring is empty.
+//
+// Big-O is O(n) because it will have to iterate through the ring
+func (s *CircularLinkedList[T]) ToString() string {
+	if s.current == nil {
+		return ""
+	}
+
+	result := ""
+	s.Each(func(_ int, value T) bool {
+		result += fmt.Sprintf("%v ", value)
+		return true
+	})
+	// Return a substring to remove the trailing space
+	return result[:len(result)-1]
+}
+
+// CurrentE returns the value of the current node
+//
+// Returns ErrEmpty if the ring is empty.
+//
+// Big-O is O(1) because s.Current is O(1)
+func (s *CircularLinkedList[T]) CurrentE() (T, error) {
+	if s.Count == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return s.Current(), nil
+}
+
+// RemoveCurrentE removes the current node and returns its value
+//
+// Returns ErrEmpty if the ring is empty.
+//
+// Big-O is O(1) because s.RemoveCurrent is O(1)
+func (s *CircularLinkedList[T]) RemoveCurrentE() (T, error) {
+	if s.Count == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return s.RemoveCurrent(), nil
+}
+
+// Validate checks that the ring's Count agrees with its nodes, and that every Prev pointer matches a Next pointer
+//
+// Returns nil if the ring is valid, or an error describing the first problem found.
+//
+// Big-O is O(n) because it walks the whole ring
+func (s *CircularLinkedList[T]) Validate() error {
+	if s.current == nil {
+		if s.Count != 0 {
+			return fmt.Errorf("count is %d, but the ring has no current node", s.Count)
+		}
+		return nil
+	}
+
+	count := 0
+	currentNode := s.current
+	for {
+		if currentNode.Next == nil || currentNode.Next.Prev != currentNode {
+			return fmt.Errorf("node %d has the wrong next node", count)
+		}
+		count++
+		currentNode = currentNode.Next
+		if currentNode == s.current {
+			break
+		}
+		// A ring that doesn't lead back to current would go on forever, so stop once we've gone past Count
+		if count > s.Count {
+			return fmt.Errorf("ring has more than %d nodes", s.Count)
+		}
+	}
+
+	if count != s.Count {
+		return fmt.Errorf("count is %d, but the ring has %d nodes", s.Count, count)
+	}
+	return nil
+}
+
+// linkAfter inserts newNode right after prevNode in a ring
+func linkAfter[T any](prevNode, newNode *doubleNode[T]) {
+	newNode.Prev = prevNode
+	newNode.Next = prevNode.Next
+	prevNode.Next.Prev = newNode
+	prevNode.Next = newNode
+}
@@ -0,0 +1,194 @@
+package linked_list
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/robertjshirts/data-structures/util"
+)
+
+func circularOf(values ...int) CircularLinkedList[int] {
+	list := EmptyCircularLinkedList[int]()
+	for _, value := range values {
+		list.InsertBeforeCurrent(value)
+	}
+	return list
+}
+
+func assertCircular(t *testing.T, list *CircularLinkedList[int], expected string) {
+	t.Helper()
+	if err := list.Validate(); err != nil {
+		t.Errorf("Expected a valid ring, got %v", err)
+	}
+	util.SimpleAssert(t, list.ToString(), expected)
+}
+
+func TestNewCircularLinkedList(t *testing.T) {
+	// Act
+	list := NewCircularLinkedList(1)
+	list.Advance()
+	// Assert
+	assertCircular(t, &list, "1")
+	util.SimpleAssert(t, list.Current(), 1)
+	util.SimpleAssert(t, list.Count, 1)
+}
+
+func TestCircularLinkedList_AdvanceWrapsAround(t *testing.T) {
+	// Arrange
+	list := circularOf(1, 2, 3)
+	var visited []int
+	// Act
+	for i := 0; i < 5; i++ {
+		visited = append(visited, list.Current())
+		list.Advance()
+	}
+	// Assert
+	util.SimpleAssert(t, len(visited), 5)
+	util.SimpleAssert(t, visited[2], 3)
+	util.SimpleAssert(t, visited[3], 1)
+	util.SimpleAssert(t, visited[4], 2)
+	assertCircular(t, &list, "3 1 2")
+}
+
+func TestCircularLinkedList_RetreatWrapsAround(t *testing.T) {
+	// Arrange
+	list := circularOf(1, 2, 3)
+	// Act
+	list.Retreat()
+	// Assert
+	util.SimpleAssert(t, list.Current(), 3)
+}
+
+func TestCircularLinkedList_AdvanceOnEmptyRing(t *testing.T) {
+	// Arrange
+	list := EmptyCircularLinkedList[int]()
+	// Act
+	list.Advance()
+	list.Retreat()
+	// Assert
+	assertCircular(t, &list, "")
+}
+
+func TestCircularLinkedList_InsertAfterCurrent(t *testing.T) {
+	// Arrange
+	list := circularOf(1, 2)
+	// Act
+	list.InsertAfterCurrent(3)
+	// Assert
+	assertCircular(t, &list, "1 3 2")
+	util.SimpleAssert(t, list.Current(), 1)
+	util.SimpleAssert(t, list.Count, 3)
+}
+
+func TestCircularLinkedList_InsertBeforeCurrent(t *testing.T) {
+	// Arrange
+	list := circularOf(1, 2)
+	// Act
+	list.InsertBeforeCurrent(3)
+	// Assert
+	assertCircular(t, &list, "1 2 3")
+	util.SimpleAssert(t, list.Current(), 1)
+}
+
+func TestCircularLinkedList_RemoveCurrent(t *testing.T) {
+	// Arrange
+	list := circularOf(1, 2, 3)
+	list.Advance()
+	// Act
+	removed := list.RemoveCurrent()
+	// Assert
+	util.SimpleAssert(t, removed, 2)
+	util.SimpleAssert(t, list.Current(), 3)
+	assertCircular(t, &list, "3 1")
+}
+
+func TestCircularLinkedList_RemoveCurrentUntilEmpty(t *testing.T) {
+	// Arrange
+	list := circularOf(1, 2)
+	// Act
+	list.RemoveCurrent()
+	list.RemoveCurrent()
+	_, err := list.RemoveCurrentE()
+	// Assert
+	assertCircular(t, &list, "")
+	util.SimpleAssert(t, errors.Is(err, ErrEmpty), true)
+}
+
+func TestCircularLinkedList_RemoveCurrentPanicsOnEmptyRing(t *testing.T) {
+	// Arrange
+	list := EmptyCircularLinkedList[int]()
+	defer func() {
+		// Assert
+		if r := recover(); r == nil {
+			t.Errorf("Expected a panic")
+		}
+	}()
+	// Act
+	list.RemoveCurrent()
+}
+
+func TestCircularLinkedList_CurrentE(t *testing.T) {
+	// Arrange
+	list := EmptyCircularLinkedList[int]()
+	// Act
+	_, emptyErr := list.CurrentE()
+	list.InsertAfterCurrent(4)
+	value, err := list.CurrentE()
+	// Assert
+	util.SimpleAssert(t, errors.Is(emptyErr, ErrEmpty), true)
+	util.SimpleAssert(t, value, 4)
+	util.SimpleAssert(t, err, nil)
+}
+
+func TestCircularLinkedList_EachStartsAtCurrent(t *testing.T) {
+	// Arrange
+	list := circularOf(1, 2, 3)
+	list.Advance()
+	var values []int
+	// Act
+	list.Each(func(index int, value int) bool {
+		values = append(values, value)
+		return index < 1
+	})
+	// Assert
+	util.SimpleAssert(t, len(values), 2)
+	util.SimpleAssert(t, values[0], 2)
+	util.SimpleAssert(t, values[1], 3)
+}
+
+func TestCircularLinkedList_EachReverse(t *testing.T) {
+	// Arrange
+	list := circularOf(1, 2, 3)
+	var indexes, values []int
+	// Act
+	list.EachReverse(func(index int, value int) bool {
+		indexes = append(indexes, index)
+		values = append(values, value)
+		return true
+	})
+	// Assert
+	util.SimpleAssert(t, len(values), 3)
+	util.SimpleAssert(t, values[0], 3)
+	util.SimpleAssert(t, indexes[0], 2)
+	util.SimpleAssert(t, values[2], 1)
+	util.SimpleAssert(t, indexes[2], 0)
+}
+
+func TestCircularLinkedList_Clear(t *testing.T) {
+	// Arrange
+	list := circularOf(1, 2, 3)
+	// Act
+	list.Clear()
+	// Assert
+	assertCircular(t, &list, "")
+}
+
+func TestCircularLinkedList_ValidateCatchesWrongCount(t *testing.T) {
+	// Arrange
+	list := circularOf(1, 2, 3)
+	list.Count = 2
+	// Act
+	err := list.Validate()
+	// Assert
+	util.SimpleAssert(t, err != nil, true)
+}
@@ -0,0 +1,398 @@
+package linked_list
+
+// DoubleCursor points at a node of a DoubleLinkedList, and can insert or remove around it in O(1).
+// The cursor stays on the same node until it's moved, so it can be kept as a handle (for example, in an LRU map).
+//
+// A cursor must not be used after its node has been removed by anything other than the cursor itself,
+// or after the list has been cleared.
+type DoubleCursor[T any] struct {
+	list *DoubleLinkedList[T]
+	node *doubleNode[T]
+}
+
+// SingleCursor points at a node of a SingleLinkedList. It also remembers the node before it,
+// which is what lets it insert before, and remove, the current node in O(1).
+//
+// A cursor must not be used after the list has been changed by anything other than the cursor itself,
+// because the node it remembers might not be the one before it anymore.
+type SingleCursor[T any] struct {
+	list *SingleLinkedList[T]
+	prev *node[T]
+	node *node[T]
+}
+
+// Front returns a cursor on the head of the list. The cursor isn't valid if the list is empty
+//
+// Big-O is O(1) because we're just pointing at the head
+func (s *DoubleLinkedList[T]) Front() *DoubleCursor[T] {
+	return &DoubleCursor[T]{list: s, node: s.Head}
+}
+
+// Back returns a cursor on the tail of the list. The cursor isn't valid if the list is empty
+//
+// Big-O is O(1) because we're just pointing at the tail
+func (s *DoubleLinkedList[T]) Back() *DoubleCursor[T] {
+	return &DoubleCursor[T]{list: s, node: s.Tail}
+}
+
+// CursorAt returns a cursor on the node at the specified index
+//
+// Panics if the index is out of bounds.
+//
+// Big-O is O(n) because s.GetNode is O(n)
+func (s *DoubleLinkedList[T]) CursorAt(index int) *DoubleCursor[T] {
+	return &DoubleCursor[T]{list: s, node: s.GetNode(index)}
+}
+
+// Valid returns true if the cursor is on a node, false if it has moved off either end of the list
+//
+// Big-O is O(1) because it's a nil check
+func (c *DoubleCursor[T]) Valid() bool {
+	return c.node != nil
+}
+
+// Value returns the value of the current node
+//
+// Panics if the cursor isn't valid.
+//
+// Big-O is O(1) because we already have the node
+func (c *DoubleCursor[T]) Value() T {
+	c.mustBeValid()
+	return c.node.Value
+}
+
+// Set changes the value of the current node
+//
+// Panics if the cursor isn't valid.
+//
+// Big-O is O(1) because we already have the node
+func (c *DoubleCursor[T]) Set(value T) {
+	c.mustBeValid()
+	c.node.Value = value
+}
+
+// Next moves the cursor to the next node, and returns true if it's still valid
+//
+// Big-O is O(1) because we just follow the Next pointer
+func (c *DoubleCursor[T]) Next() bool {
+	if c.node != nil {
+		c.node = c.node.Next
+	}
+	return c.node != nil
+}
+
+// Prev moves the cursor to the previous node, and returns true if it's still valid
+//
+// Big-O is O(1) because we just follow the Prev pointer
+func (c *DoubleCursor[T]) Prev() bool {
+	if c.node != nil {
+		c.node = c.node.Prev
+	}
+	return c.node != nil
+}
+
+// InsertBefore inserts a value before the current node. The cursor doesn't move
+//
+// Panics if the cursor isn't valid.
+//
+// Big-O is O(1) because we're just reassigning pointers
+func (c *DoubleCursor[T]) InsertBefore(value T) {
+	c.mustBeValid()
+	newNode := &doubleNode[T]{
+		Value: value,
+		Next:  c.node,
+		Prev:  c.node.Prev,
+	}
+	if c.node.Prev == nil {
+		c.list.Head = newNode
+	} else {
+		c.node.Prev.Next = newNode
+	}
+	c.node.Prev = newNode
+	c.list.Count++
+}
+
+// InsertAfter inserts a value after the current node. The cursor doesn't move
+//
+// Panics if the cursor isn't valid.
+//
+// Big-O is O(1) because we're just reassigning pointers
+func (c *DoubleCursor[T]) InsertAfter(value T) {
+	c.mustBeValid()
+	newNode := &doubleNode[T]{
+		Value: value,
+		Next:  c.node.Next,
+		Prev:  c.node,
+	}
+	if c.node.Next == nil {
+		c.list.Tail = newNode
+	} else {
+		c.node.Next.Prev = newNode
+	}
+	c.node.Next = newNode
+	c.list.Count++
+}
+
+// Remove removes the current node and returns its value. The cursor moves to the next node,
+// so a loop can keep going after removing
+//
+// Panics if the cursor isn't valid.
+//
+// Big-O is O(1) because we're just reassigning pointers
+func (c *DoubleCursor[T]) Remove() T {
+	c.mustBeValid()
+	removed := c.node
+	c.node = removed.Next
+	c.list.unlink(removed)
+	return removed.Value
+}
+
+// MoveToFront moves the current node to the head of the list. The cursor moves with it
+//
+// Panics if the cursor isn't valid.
+//
+// Big-O is O(1) because we're just reassigning pointers
+func (c *DoubleCursor[T]) MoveToFront() {
+	c.mustBeValid()
+	if c.node == c.list.Head {
+		return
+	}
+	c.list.unlink(c.node)
+	c.node.Next = c.list.Head
+	c.list.Head.Prev = c.node
+	c.list.Head = c.node
+	c.list.Count++
+}
+
+// MoveToBack moves the current node to the tail of the list. The cursor moves with it
+//
+// Panics if the cursor isn't valid.
+//
+// Big-O is O(1) because we're just reassigning pointers
+func (c *DoubleCursor[T]) MoveToBack() {
+	c.mustBeValid()
+	if c.node == c.list.Tail {
+		return
+	}
+	c.list.unlink(c.node)
+	c.node.Prev = c.list.Tail
+	c.list.Tail.Next = c.node
+	c.list.Tail = c.node
+	c.list.Count++
+}
+
+func (c *DoubleCursor[T]) mustBeValid() {
+	if c.node == nil {
+		panic("Cursor is not on a node")
+	}
+}
+
+// unlink takes a node out of the list and fixes the pointers around it
+//
+// Big-O is O(1) because we're just reassigning pointers
+func (s *DoubleLinkedList[T]) unlink(node *doubleNode[T]) {
+	if node.Prev == nil {
+		s.Head = node.Next
+	} else {
+		node.Prev.Next = node.Next
+	}
+	if node.Next == nil {
+		s.Tail = node.Prev
+	} else {
+		node.Next.Prev = node.Prev
+	}
+	node.Next = nil
+	node.Prev = nil
+	s.Count--
+}
+
+// Front returns a cursor on the head of the list. The cursor isn't valid if the list is empty
+//
+// Big-O is O(1) because we're just pointing at the head
+func (s *SingleLinkedList[T]) Front() *SingleCursor[T] {
+	return &SingleCursor[T]{list: s, node: s.Head}
+}
+
+// CursorAt returns a cursor on the node at the specified index
+//
+// # Panics if the index is out of bounds
+//
+// Big-O is O(n) because s.GetNode is O(n)
+func (s *SingleLinkedList[T]) CursorAt(index int) *SingleCursor[T] {
+	if index == 0 {
+		// Still panics if the list is empty
+		return &SingleCursor[T]{list: s, node: s.GetNode(0)}
+	}
+	prev := s.GetNode(index - 1)
+	if prev.Next == nil {
+		panic("Index out of bounds!")
+	}
+	return &SingleCursor[T]{list: s, prev: prev, node: prev.Next}
+}
+
+// Valid returns true if the cursor is on a node, false if it has moved off either end of the list
+//
+// Big-O is O(1) because it's a nil check
+func (c *SingleCursor[T]) Valid() bool {
+	return c.node != nil
+}
+
+// Value returns the value of the current node
+//
+// # Panics if the cursor isn't valid
+//
+// Big-O is O(1) because we already have the node
+func (c *SingleCursor[T]) Value() T {
+	c.mustBeValid()
+	return c.node.Value
+}
+
+// Set changes the value of the current node
+//
+// # Panics if the cursor isn't valid
+//
+// Big-O is O(1) because we already have the node
+func (c *SingleCursor[T]) Set(value T) {
+	c.mustBeValid()
+	c.node.Value = value
+}
+
+// Next moves the cursor to the next node, and returns true if it's still valid
+//
+// Big-O is O(1) because we just follow the Next pointer
+func (c *SingleCursor[T]) Next() bool {
+	if c.node != nil {
+		c.prev = c.node
+		c.node = c.node.Next
+	}
+	return c.node != nil
+}
+
+// Prev moves the cursor to the previous node, and returns true if it's still valid
+//
+// Big-O is O(n) because a single linked list has no Prev pointers,
+// so we have to walk from the head to find the node before the previous one
+func (c *SingleCursor[T]) Prev() bool {
+	if c.node == nil && c.prev == nil {
+		return false
+	}
+	c.node = c.prev
+	if c.node == nil || c.node == c.list.Head {
+		c.prev = nil
+		return c.node != nil
+	}
+
+	prev := c.list.Head
+	for prev.Next != c.node {
+		prev = prev.Next
+	}
+	c.prev = prev
+	return true
+}
+
+// InsertBefore inserts a value before the current node. The cursor doesn't move
+//
+// # Panics if the cursor isn't valid
+//
+// Big-O is O(1) because we remember the node before the cursor
+func (c *SingleCursor[T]) InsertBefore(value T) {
+	c.mustBeValid()
+	newNode := &node[T]{
+		Value: value,
+		Next:  c.node,
+	}
+	if c.prev == nil {
+		c.list.Head = newNode
+	} else {
+		c.prev.Next = newNode
+	}
+	c.prev = newNode
+	c.list.Count++
+}
+
+// InsertAfter inserts a value after the current node. The cursor doesn't move
+//
+// # Panics if the cursor isn't valid
+//
+// Big-O is O(1) because we're just reassigning pointers
+func (c *SingleCursor[T]) InsertAfter(value T) {
+	c.mustBeValid()
+	newNode := &node[T]{
+		Value: value,
+		Next:  c.node.Next,
+	}
+	if c.node == c.list.Tail {
+		c.list.Tail = newNode
+	}
+	c.node.Next = newNode
+	c.list.Count++
+}
+
+// Remove removes the current node and returns its value. The cursor moves to the next node,
+// so a loop can keep going after removing
+//
+// # Panics if the cursor isn't valid
+//
+// Big-O is O(1) because we remember the node before the cursor
+func (c *SingleCursor[T]) Remove() T {
+	c.mustBeValid()
+	removed := c.node
+	c.unlink()
+	c.node = removed.Next
+	removed.Next = nil
+	return removed.Value
+}
+
+// MoveToFront moves the current node to the head of the list. The cursor moves with it
+//
+// # Panics if the cursor isn't valid
+//
+// Big-O is O(1) because we remember the node before the cursor
+func (c *SingleCursor[T]) MoveToFront() {
+	c.mustBeValid()
+	if c.prev == nil {
+		return
+	}
+	c.unlink()
+	c.node.Next = c.list.Head
+	c.list.Head = c.node
+	c.list.Count++
+	c.prev = nil
+}
+
+// MoveToBack moves the current node to the tail of the list. The cursor moves with it
+//
+// # Panics if the cursor isn't valid
+//
+// Big-O is O(1) because we remember the node before the cursor, and keep a pointer to the tail
+func (c *SingleCursor[T]) MoveToBack() {
+	c.mustBeValid()
+	if c.node == c.list.Tail {
+		return
+	}
+	c.unlink()
+	c.node.Next = nil
+	c.prev = c.list.Tail
+	c.list.Tail.Next = c.node
+	c.list.Tail = c.node
+	c.list.Count++
+}
+
+// unlink takes the current node out of the list, using the node before it
+func (c *SingleCursor[T]) unlink() {
+	if c.prev == nil {
+		c.list.Head = c.node.Next
+	} else {
+		c.prev.Next = c.node.Next
+	}
+	if c.node == c.list.Tail {
+		c.list.Tail = c.prev
+	}
+	c.list.Count--
+}
+
+func (c *SingleCursor[T]) mustBeValid() {
+	if c.node == nil {
+		panic("Cursor is not on a node")
+	}
+}
@@ -0,0 +1,287 @@
+package linked_list
+
+import (
+	"github.com/robertjshirts/data-structures/util"
+	"testing"
+)
+
+func TestDoubleCursor_IteratesForward(t *testing.T) {
+	// Arrange
+	list := NewDoubleLinkedList(0)
+	list.Add(1)
+	list.Add(2)
+	count := 0
+	// Act
+	for c := list.Front(); c.Valid(); c.Next() {
+		util.SimpleAssert(t, c.Value(), count)
+		count++
+	}
+	// Assert
+	util.SimpleAssert(t, count, 3)
+}
+
+func TestDoubleCursor_IteratesBackward(t *testing.T) {
+	// Arrange
+	list := NewDoubleLinkedList(0)
+	list.Add(1)
+	list.Add(2)
+	expected := 2
+	// Act
+	for c := list.Back(); c.Valid(); c.Prev() {
+		util.SimpleAssert(t, c.Value(), expected)
+		expected--
+	}
+	// Assert
+	util.SimpleAssert(t, expected, -1)
+}
+
+func TestDoubleCursor_FrontOnEmptyListIsNotValid(t *testing.T) {
+	// Arrange
+	list := EmptyDoubleLinkedList[int]()
+	// Act
+	c := list.Front()
+	// Assert
+	util.SimpleAssert(t, c.Valid(), false)
+}
+
+func TestDoubleCursor_InsertBeforeAndAfter(t *testing.T) {
+	// Arrange
+	list := NewDoubleLinkedList(1)
+	c := list.Front()
+	// Act
+	c.InsertBefore(0)
+	c.InsertAfter(2)
+	// Assert
+	util.SimpleAssert(t, list.ToString(), "0 1 2")
+	util.SimpleAssert(t, list.Head.Value, 0)
+	util.SimpleAssert(t, list.Tail.Value, 2)
+	util.SimpleAssert(t, list.Tail.Prev.Value, 1)
+	util.SimpleAssert(t, list.Count, 3)
+	util.SimpleAssert(t, c.Value(), 1)
+}
+
+func TestDoubleCursor_RemoveWhileIterating(t *testing.T) {
+	// Arrange
+	list := EmptyDoubleLinkedList[int]()
+	for i := 0; i < 6; i++ {
+		list.Add(i)
+	}
+	// Act
+	for c := list.Front(); c.Valid(); {
+		if c.Value()%2 == 0 {
+			c.Remove()
+		} else {
+			c.Next()
+		}
+	}
+	// Assert
+	util.SimpleAssert(t, list.ToString(), "1 3 5")
+	util.SimpleAssert(t, list.Count, 3)
+	util.SimpleAssert(t, list.Head.Prev, nil)
+	util.SimpleAssert(t, list.Tail.Value, 5)
+}
+
+func TestDoubleCursor_RemoveOnlyNode(t *testing.T) {
+	// Arrange
+	list := NewDoubleLinkedList(1)
+	c := list.Front()
+	// Act
+	value := c.Remove()
+	// Assert
+	util.SimpleAssert(t, value, 1)
+	util.SimpleAssert(t, c.Valid(), false)
+	util.NilAssert(t, list.Head)
+	util.NilAssert(t, list.Tail)
+	util.SimpleAssert(t, list.Count, 0)
+}
+
+func TestDoubleCursor_MoveToFrontKeepsHandle(t *testing.T) {
+	// Arrange
+	list := NewDoubleLinkedList("a")
+	list.Add("b")
+	list.Add("c")
+	c := list.Back()
+	// Act
+	c.MoveToFront()
+	// Assert
+	util.SimpleAssert(t, list.ToString(), "c a b")
+	util.SimpleAssert(t, list.Tail.Value, "b")
+	util.SimpleAssert(t, list.Count, 3)
+	util.SimpleAssert(t, c.Value(), "c")
+	util.SimpleAssert(t, c.Prev(), false)
+}
+
+func TestDoubleCursor_MoveToBack(t *testing.T) {
+	// Arrange
+	list := NewDoubleLinkedList("a")
+	list.Add("b")
+	list.Add("c")
+	c := list.CursorAt(1)
+	// Act
+	c.MoveToBack()
+	// Assert
+	util.SimpleAssert(t, list.ToString(), "a c b")
+	util.SimpleAssert(t, list.Tail.Prev.Value, "c")
+	util.SimpleAssert(t, c.Next(), false)
+}
+
+func TestDoubleCursor_SetChangesValue(t *testing.T) {
+	// Arrange
+	list := NewDoubleLinkedList(1)
+	c := list.Front()
+	// Act
+	c.Set(5)
+	// Assert
+	util.SimpleAssert(t, list.Head.Value, 5)
+}
+
+func TestDoubleCursor_ValuePanicsWhenNotValid(t *testing.T) {
+	// Arrange
+	list := EmptyDoubleLinkedList[int]()
+	c := list.Front()
+	defer func() {
+		// Assert
+		if r := recover(); r == nil {
+			t.Errorf("The code did not panic")
+		}
+	}()
+	// Act
+	c.Value()
+}
+
+func TestSingleCursor_IteratesForward(t *testing.T) {
+	// Arrange
+	list := NewSingleLinkedList(0)
+	list.Add(1)
+	list.Add(2)
+	count := 0
+	// Act
+	for c := list.Front(); c.Valid(); c.Next() {
+		util.SimpleAssert(t, c.Value(), count)
+		count++
+	}
+	// Assert
+	util.SimpleAssert(t, count, 3)
+}
+
+func TestSingleCursor_Prev(t *testing.T) {
+	// Arrange
+	list := NewSingleLinkedList(0)
+	list.Add(1)
+	list.Add(2)
+	c := list.CursorAt(2)
+	// Act
+	c.Prev()
+	// Assert
+	util.SimpleAssert(t, c.Value(), 1)
+	util.SimpleAssert(t, c.Prev(), true)
+	util.SimpleAssert(t, c.Value(), 0)
+	util.SimpleAssert(t, c.Prev(), false)
+}
+
+func TestSingleCursor_PrevFromPastTheEnd(t *testing.T) {
+	// Arrange
+	list := NewSingleLinkedList(0)
+	list.Add(1)
+	c := list.Front()
+	c.Next()
+	c.Next()
+	// Act
+	valid := c.Prev()
+	// Assert
+	util.SimpleAssert(t, valid, true)
+	util.SimpleAssert(t, c.Value(), 1)
+}
+
+func TestSingleCursor_InsertBeforeAndAfter(t *testing.T) {
+	// Arrange
+	list := NewSingleLinkedList(1)
+	c := list.Front()
+	// Act
+	c.InsertBefore(0)
+	c.InsertAfter(3)
+	c.Next()
+	c.InsertBefore(2)
+	// Assert
+	util.SimpleAssert(t, list.ToString(), "0 1 2 3 \n")
+	util.SimpleAssert(t, list.Tail.Value, 3)
+	util.SimpleAssert(t, list.Count, 4)
+	util.SimpleAssert(t, c.Value(), 3)
+}
+
+func TestSingleCursor_RemoveWhileIterating(t *testing.T) {
+	// Arrange
+	list := EmptySingleLinkedList[int]()
+	for i := 0; i < 6; i++ {
+		list.Add(i)
+	}
+	// Act
+	for c := list.Front(); c.Valid(); {
+		if c.Value()%2 == 1 {
+			c.Remove()
+		} else {
+			c.Next()
+		}
+	}
+	// Assert
+	util.SimpleAssert(t, list.ToString(), "0 2 4 \n")
+	util.SimpleAssert(t, list.Count, 3)
+	util.SimpleAssert(t, list.Tail.Value, 4)
+	util.NilAssert(t, list.Tail.Next)
+}
+
+func TestSingleCursor_RemoveOnlyNode(t *testing.T) {
+	// Arrange
+	list := NewSingleLinkedList(1)
+	c := list.Front()
+	// Act
+	c.Remove()
+	// Assert
+	util.SimpleAssert(t, c.Valid(), false)
+	util.NilAssert(t, list.Head)
+	util.NilAssert(t, list.Tail)
+	util.SimpleAssert(t, list.Count, 0)
+}
+
+func TestSingleCursor_MoveToFront(t *testing.T) {
+	// Arrange
+	list := NewSingleLinkedList("a")
+	list.Add("b")
+	list.Add("c")
+	c := list.CursorAt(2)
+	// Act
+	c.MoveToFront()
+	// Assert
+	util.SimpleAssert(t, list.ToString(), "c a b \n")
+	util.SimpleAssert(t, list.Tail.Value, "b")
+	util.SimpleAssert(t, list.Count, 3)
+	util.SimpleAssert(t, c.Value(), "c")
+}
+
+func TestSingleCursor_MoveToBack(t *testing.T) {
+	// Arrange
+	list := NewSingleLinkedList("a")
+	list.Add("b")
+	list.Add("c")
+	c := list.Front()
+	// Act
+	c.MoveToBack()
+	list.Add("d")
+	// Assert
+	util.SimpleAssert(t, list.ToString(), "b c a d \n")
+	util.SimpleAssert(t, list.Count, 4)
+	util.SimpleAssert(t, c.Value(), "a")
+}
+
+func TestSingleCursor_CursorAtPanicsOnInvalidIndex(t *testing.T) {
+	// Arrange
+	list := NewSingleLinkedList(1)
+	defer func() {
+		// Assert
+		if r := recover(); r == nil {
+			t.Errorf("The code did not panic")
+		}
+	}()
+	// Act
+	list.CursorAt(1)
+}
@@ -2,13 +2,13 @@ package linked_list
 
 import "fmt"
 
-type doubleNode[T comparable] struct {
+type doubleNode[T any] struct {
 	Value T
 	Next  *doubleNode[T]
 	Prev  *doubleNode[T]
 }
 
-type DoubleLinkedList[T comparable] struct {
+type DoubleLinkedList[T any] struct {
 	Head  *doubleNode[T]
 	Tail  *doubleNode[T]
 	Count int
@@ -19,7 +19,7 @@ type DoubleLinkedList[T comparable] struct {
 // Returns a list with no head or tail and a count of 0.
 //
 // Big-O is O(1) because we're just instantiating a new node
-func EmptyDoubleLinkedList[T comparable]() DoubleLinkedList[T] {
+func EmptyDoubleLinkedList[T any]() DoubleLinkedList[T] {
 	return DoubleLinkedList[T]{
 		Head:  nil,
 		Tail:  nil,
@@ -32,7 +32,7 @@ func EmptyDoubleLinkedList[T comparable]() DoubleLinkedList[T] {
 // Returns a list with a single node with the value of firstItem.
 //
 // Big-O is O(1) because we're just instantiating a new node
-func NewDoubleLinkedList[T comparable](firstItem T) DoubleLinkedList[T] {
+func NewDoubleLinkedList[T any](firstItem T) DoubleLinkedList[T] {
 	Node := doubleNode[T]{
 		Value: firstItem,
 		Next:  nil,
@@ -45,7 +45,7 @@ func NewDoubleLinkedList[T comparable](firstItem T) DoubleLinkedList[T] {
 	}
 }
 
-// Push adds an item to the start of the list
+// Push adds an item to the start of the list. Will reassign tail if the list is empty.
 //
 // Big-O is O(1) because we're just reassigning the head
 func (s *DoubleLinkedList[T]) Push(item T) {
@@ -54,7 +54,11 @@ func (s *DoubleLinkedList[T]) Push(item T) {
 		Next:  s.Head,
 		Prev:  nil,
 	}
-	s.Head.Prev = &newNode
+	if s.Head == nil {
+		s.Tail = &newNode
+	} else {
+		s.Head.Prev = &newNode
+	}
 	s.Head = &newNode
 	s.Count++
 }
@@ -86,7 +90,7 @@ func (s *DoubleLinkedList[T]) Add(item T) {
 //
 // Panics if the index is out of bounds.
 //
-// Big-O is O(n) because s.GetNode is O(n) and the rest of the expressions are constant
+// Big-O is O(n) because s.GetNode is O(n), but only walks from the closer end, and the rest of the expressions are constant
 func (s *DoubleLinkedList[T]) Insert(value T, index int) {
 	// If index out of bounds, panic
 	// Don't use s.Count -1 bc we could insert at the tail
@@ -173,7 +177,7 @@ func (s *DoubleLinkedList[T]) Remove() T {
 //
 // Panics if the index is out of bounds.
 //
-// Big-O is O(n) because it calls s.GetNode which is O(n)
+// Big-O is O(n) because it calls s.GetNode which is O(n), but only walks from the closer end
 func (s *DoubleLinkedList[T]) RemoveAt(index int) T {
 	// If index is 0 just call remove, don't decrement bc its alr handled
 	// No need to panic here because Remove & RemoveLast will panic if index is out of bounds
@@ -234,15 +238,25 @@ func (s *DoubleLinkedList[T]) Clear() {
 	s.Count = 0
 }
 
-// Search searches for a value in the list and returns its index
+// SearchDouble searches for a value in the list and returns its index.
+// It's a function instead of a method because only comparable values can be checked with ==
+//
+// Returns the index of the value if found, -1 otherwise.
+//
+// Big-O is O(n) because SearchFunc is O(n)
+func SearchDouble[T comparable](s *DoubleLinkedList[T], value T) int {
+	return s.SearchFunc(func(other T) bool { return other == value })
+}
+
+// SearchFunc searches for the first value that match returns true for and returns its index
 //
 // Returns the index of the value if found, -1 otherwise.
 //
 // Big-O is O(n) because there is a for loop that iterates over the list
-func (s *DoubleLinkedList[T]) Search(value T) int {
+func (s *DoubleLinkedList[T]) SearchFunc(match func(T) bool) int {
 	currentNode := s.Head
 	for i := 0; i < s.Count; i++ {
-		if currentNode.Value == value {
+		if match(currentNode.Value) {
 			return i
 		}
 		currentNode = currentNode.Next
@@ -250,18 +264,53 @@ func (s *DoubleLinkedList[T]) Search(value T) int {
 	return -1
 }
 
+// SearchLastDouble searches for the last occurrence of a value in the list and returns its index
+//
+// Returns the index of the value if found, -1 otherwise.
+//
+// Big-O is O(n) because SearchLastFunc is O(n)
+func SearchLastDouble[T comparable](s *DoubleLinkedList[T], value T) int {
+	return s.SearchLastFunc(func(other T) bool { return other == value })
+}
+
+// SearchLastFunc searches for the last value that match returns true for and returns its index
+//
+// Returns the index of the value if found, -1 otherwise.
+//
+// Big-O is O(n) because there is a for loop that iterates over the list, starting from the tail
+func (s *DoubleLinkedList[T]) SearchLastFunc(match func(T) bool) int {
+	currentNode := s.Tail
+	for i := s.Count - 1; i >= 0; i-- {
+		if match(currentNode.Value) {
+			return i
+		}
+		currentNode = currentNode.Prev
+	}
+	return -1
+}
+
 // GetNode finds and returns the node at the specified index
 //
 // Returns a pointer to the node at the specified index.
 //
 // Panics if the index is out of bounds.
 //
-// Big-O is O(n) because there is a for loop that iterates over the list
+// Big-O is O(n) because there is a for loop that iterates over the list.
+// It walks from whichever end is closer, so it never looks at more than half the list
 func (s *DoubleLinkedList[T]) GetNode(index int) *doubleNode[T] {
 	if index < 0 || index >= s.Count {
 		panic("Index out of bounds")
 	}
 
+	// Closer to the tail, so walk backwards
+	if index >= s.Count/2 {
+		node := s.Tail
+		for i := s.Count - 1; i > index; i-- {
+			node = node.Prev
+		}
+		return node
+	}
+
 	node := s.Head
 	for i := 0; i < index; i++ {
 		node = node.Next
@@ -270,6 +319,34 @@ func (s *DoubleLinkedList[T]) GetNode(index int) *doubleNode[T] {
 	return node
 }
 
+// Each calls fn with the index and value of each node, from head to tail.
+// Stops early if fn returns false.
+//
+// Big-O is O(n) because it will have to iterate through the list
+func (s *DoubleLinkedList[T]) Each(fn func(index int, value T) bool) {
+	currentNode := s.Head
+	for i := 0; currentNode != nil; i++ {
+		if !fn(i, currentNode.Value) {
+			return
+		}
+		currentNode = currentNode.Next
+	}
+}
+
+// EachReverse calls fn with the index and value of each node, from tail to head.
+// Stops early if fn returns false.
+//
+// Big-O is O(n) because it will have to iterate through the list
+func (s *DoubleLinkedList[T]) EachReverse(fn func(index int, value T) bool) {
+	currentNode := s.Tail
+	for i := s.Count - 1; currentNode != nil; i-- {
+		if !fn(i, currentNode.Value) {
+			return
+		}
+		currentNode = currentNode.Prev
+	}
+}
+
 // ToString converts the list to a string
 //
 // Returns a string representation of the list in the following format: "1 2 3 4".
@@ -291,3 +368,91 @@ func (s *DoubleLinkedList[T]) ToString() string {
 	// Return a substring to remove the trailing space
 	return result[:len(result)-1]
 }
+
+// TryGet returns the value at the specified index
+//
+// Returns the value and true, or the zero value and false if the index is out of bounds.
+//
+// Big-O is O(n) because s.GetNode is O(n)
+func (s *DoubleLinkedList[T]) TryGet(index int) (T, bool) {
+	value, err := s.GetE(index)
+	return value, err == nil
+}
+
+// GetE returns the value at the specified index
+//
+// Returns ErrIndexOutOfRange if the index is out of bounds.
+//
+// Big-O is O(n) because s.GetNode is O(n)
+func (s *DoubleLinkedList[T]) GetE(index int) (T, error) {
+	node, err := s.GetNodeE(index)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return node.Value, nil
+}
+
+// GetNodeE finds and returns the node at the specified index
+//
+// Returns ErrIndexOutOfRange if the index is out of bounds.
+//
+// Big-O is O(n) because s.GetNode is O(n)
+func (s *DoubleLinkedList[T]) GetNodeE(index int) (*doubleNode[T], error) {
+	if index < 0 || index >= s.Count {
+		return nil, outOfRange(index, s.Count)
+	}
+	return s.GetNode(index), nil
+}
+
+// InsertE inserts a value at the specified index
+//
+// Returns ErrIndexOutOfRange if the index is out of bounds.
+//
+// Big-O is O(n) because s.Insert is O(n)
+func (s *DoubleLinkedList[T]) InsertE(value T, index int) error {
+	if index < 0 || index > s.Count {
+		return outOfRange(index, s.Count)
+	}
+	s.Insert(value, index)
+	return nil
+}
+
+// RemoveE removes the head node and returns its value
+//
+// Returns ErrEmpty if the list is empty.
+//
+// Big-O is O(1) because s.Remove is O(1)
+func (s *DoubleLinkedList[T]) RemoveE() (T, error) {
+	if s.Count == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return s.Remove(), nil
+}
+
+// RemoveAtE removes the node at the specified index and returns its value
+//
+// Returns ErrIndexOutOfRange if the index is out of bounds.
+//
+// Big-O is O(n) because s.RemoveAt is O(n)
+func (s *DoubleLinkedList[T]) RemoveAtE(index int) (T, error) {
+	if index < 0 || index >= s.Count {
+		var zero T
+		return zero, outOfRange(index, s.Count)
+	}
+	return s.RemoveAt(index), nil
+}
+
+// RemoveLastE removes the tail node and returns its value
+//
+// Returns ErrEmpty if the list is empty.
+//
+// Big-O is O(1) because s.RemoveLast is O(1)
+func (s *DoubleLinkedList[T]) RemoveLastE() (T, error) {
+	if s.Count == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return s.RemoveLast(), nil
+}
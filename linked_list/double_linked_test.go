@@ -400,7 +400,7 @@ func TestDoubleLinkedList_SearchReturnsCorrectIndex(t *testing.T) {
 	list.Add(2)
 	list.Add(3)
 	// Act
-	actual := list.Search(value)
+	actual := SearchDouble(&list, value)
 	// Assert
 	util.SimpleAssert(t, actual, expected)
 }
@@ -414,7 +414,7 @@ func TestDoubleLinkedList_SearchReturnsMinusOneIfNotFound(t *testing.T) {
 	list.Add(2)
 	list.Add(3)
 	// Act
-	actual := list.Search(value)
+	actual := SearchDouble(&list, value)
 	// Assert
 	util.SimpleAssert(t, actual, expected)
 }
@@ -425,7 +425,7 @@ func TestDoubleLinkedList_SearchReturnsMinusOneOnEmptyList(t *testing.T) {
 	expected := -1
 	list := EmptyDoubleLinkedList[int]()
 	// Act
-	actual := list.Search(value)
+	actual := SearchDouble(&list, value)
 	// Assert
 	util.SimpleAssert(t, actual, expected)
 }
@@ -505,3 +505,142 @@ func TestDoubleLinkedList_ToStringWorksOnEmptyList(t *testing.T) {
 	// Assert
 	util.SimpleAssert(t, actual, expected)
 }
+
+func TestDoubleLinkedList_GetNodeFromEitherEnd(t *testing.T) {
+	// Arrange
+	list := EmptyDoubleLinkedList[int]()
+	for i := 0; i < 7; i++ {
+		list.Add(i)
+	}
+	// Act & Assert
+	for i := 0; i < 7; i++ {
+		util.SimpleAssert(t, list.GetNode(i).Value, i)
+	}
+}
+
+func TestDoubleLinkedList_InsertNearTail(t *testing.T) {
+	// Arrange
+	list := NewDoubleLinkedList(0)
+	list.Add(1)
+	list.Add(2)
+	list.Add(4)
+	expected := "0 1 2 3 4"
+	// Act
+	list.Insert(3, 3)
+	// Assert
+	util.SimpleAssert(t, list.ToString(), expected)
+	util.SimpleAssert(t, list.Tail.Prev.Value, 3)
+}
+
+func TestDoubleLinkedList_RemoveAtNearTail(t *testing.T) {
+	// Arrange
+	list := NewDoubleLinkedList(0)
+	list.Add(1)
+	list.Add(2)
+	list.Add(3)
+	list.Add(4)
+	expected := "0 1 2 4"
+	// Act
+	actual := list.RemoveAt(3)
+	// Assert
+	util.SimpleAssert(t, actual, 3)
+	util.SimpleAssert(t, list.ToString(), expected)
+	util.SimpleAssert(t, list.Tail.Prev.Value, 2)
+}
+
+func TestDoubleLinkedList_SearchLastReturnsLastIndex(t *testing.T) {
+	// Arrange
+	list := NewDoubleLinkedList(1)
+	list.Add(2)
+	list.Add(1)
+	list.Add(3)
+	expected := 2
+	// Act
+	actual := SearchLastDouble(&list, 1)
+	// Assert
+	util.SimpleAssert(t, actual, expected)
+}
+
+func TestDoubleLinkedList_SearchLastReturnsMinusOneIfNotFound(t *testing.T) {
+	// Arrange
+	list := NewDoubleLinkedList(1)
+	list.Add(2)
+	// Act
+	actual := SearchLastDouble(&list, 4)
+	// Assert
+	util.SimpleAssert(t, actual, -1)
+}
+
+func TestDoubleLinkedList_SearchLastOnEmptyList(t *testing.T) {
+	// Arrange
+	list := EmptyDoubleLinkedList[int]()
+	// Act
+	actual := SearchLastDouble(&list, 4)
+	// Assert
+	util.SimpleAssert(t, actual, -1)
+}
+
+func TestDoubleLinkedList_EachVisitsInOrder(t *testing.T) {
+	// Arrange
+	list := NewDoubleLinkedList(0)
+	list.Add(1)
+	list.Add(2)
+	var values []int
+	// Act
+	list.Each(func(index int, value int) bool {
+		util.SimpleAssert(t, index, value)
+		values = append(values, value)
+		return true
+	})
+	// Assert
+	util.SimpleAssert(t, len(values), 3)
+}
+
+func TestDoubleLinkedList_EachReverseVisitsFromTail(t *testing.T) {
+	// Arrange
+	list := NewDoubleLinkedList(0)
+	list.Add(1)
+	list.Add(2)
+	var values []int
+	// Act
+	list.EachReverse(func(index int, value int) bool {
+		util.SimpleAssert(t, index, value)
+		values = append(values, value)
+		return true
+	})
+	// Assert
+	util.SimpleAssert(t, len(values), 3)
+	util.SimpleAssert(t, values[0], 2)
+	util.SimpleAssert(t, values[2], 0)
+}
+
+func TestDoubleLinkedList_EachReverseStopsEarly(t *testing.T) {
+	// Arrange
+	list := NewDoubleLinkedList(0)
+	list.Add(1)
+	list.Add(2)
+	calls := 0
+	// Act
+	list.EachReverse(func(index int, value int) bool {
+		calls++
+		return value != 1
+	})
+	// Assert
+	util.SimpleAssert(t, calls, 2)
+}
+
+func TestDoubleLinkedList_HoldsFuncs(t *testing.T) {
+	// Arrange
+	list := EmptyDoubleLinkedList[func() int]()
+	list.Add(func() int { return 1 })
+	list.Add(func() int { return 2 })
+	list.Add(func() int { return 1 })
+	isOne := func(fn func() int) bool { return fn() == 1 }
+	// Act
+	first := list.SearchFunc(isOne)
+	last := list.SearchLastFunc(isOne)
+	// Assert
+	util.SimpleAssert(t, first, 0)
+	util.SimpleAssert(t, last, 2)
+	util.SimpleAssert(t, list.Get(1)(), 2)
+}
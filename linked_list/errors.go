@@ -0,0 +1,18 @@
+package linked_list
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrIndexOutOfRange is returned when an index is negative or past the end of the list.
+// Check for it with errors.Is, the returned error also says which index was used.
+var ErrIndexOutOfRange = errors.New("index out of range")
+
+// ErrEmpty is returned when removing from a list with no elements
+var ErrEmpty = errors.New("list is empty")
+
+// outOfRange wraps ErrIndexOutOfRange with the index and the count of the list
+func outOfRange(index, count int) error {
+	return fmt.Errorf("%w: index %d, count %d", ErrIndexOutOfRange, index, count)
+}
@@ -0,0 +1,147 @@
+package linked_list
+
+import (
+	"errors"
+	"github.com/robertjshirts/data-structures/util"
+	"testing"
+)
+
+func TestSingleLinkedList_TryGet(t *testing.T) {
+	// Arrange
+	list := NewSingleLinkedList(1)
+	list.Add(2)
+	// Act
+	value, ok := list.TryGet(1)
+	_, outOfRange := list.TryGet(2)
+	// Assert
+	util.SimpleAssert(t, value, 2)
+	util.SimpleAssert(t, ok, true)
+	util.SimpleAssert(t, outOfRange, false)
+}
+
+func TestSingleLinkedList_GetEReturnsIndexError(t *testing.T) {
+	// Arrange
+	list := NewSingleLinkedList(1)
+	// Act
+	_, err := list.GetE(-1)
+	// Assert
+	util.SimpleAssert(t, errors.Is(err, ErrIndexOutOfRange), true)
+}
+
+func TestSingleLinkedList_InsertE(t *testing.T) {
+	// Arrange
+	list := NewSingleLinkedList(1)
+	// Act
+	err := list.InsertE(2, 1)
+	badErr := list.InsertE(3, 5)
+	// Assert
+	util.SimpleAssert(t, err, nil)
+	util.SimpleAssert(t, errors.Is(badErr, ErrIndexOutOfRange), true)
+	util.SimpleAssert(t, list.Count, 2)
+}
+
+func TestSingleLinkedList_RemoveEReturnsEmptyError(t *testing.T) {
+	// Arrange
+	list := EmptySingleLinkedList[int]()
+	// Act
+	_, err := list.RemoveE()
+	_, lastErr := list.RemoveLastE()
+	_, lastValueErr := list.LastE()
+	// Assert
+	util.SimpleAssert(t, errors.Is(err, ErrEmpty), true)
+	util.SimpleAssert(t, errors.Is(lastErr, ErrEmpty), true)
+	util.SimpleAssert(t, errors.Is(lastValueErr, ErrEmpty), true)
+}
+
+func TestSingleLinkedList_RemoveAtE(t *testing.T) {
+	// Arrange
+	list := NewSingleLinkedList(1)
+	list.Add(2)
+	// Act
+	value, err := list.RemoveAtE(1)
+	_, badErr := list.RemoveAtE(1)
+	// Assert
+	util.SimpleAssert(t, value, 2)
+	util.SimpleAssert(t, err, nil)
+	util.SimpleAssert(t, errors.Is(badErr, ErrIndexOutOfRange), true)
+	util.SimpleAssert(t, list.Count, 1)
+}
+
+func TestSingleLinkedList_GetNodeE(t *testing.T) {
+	// Arrange
+	list := NewSingleLinkedList(1)
+	// Act
+	node, err := list.GetNodeE(0)
+	_, badErr := list.GetNodeE(1)
+	// Assert
+	util.SimpleAssert(t, node, list.Head)
+	util.SimpleAssert(t, err, nil)
+	util.SimpleAssert(t, errors.Is(badErr, ErrIndexOutOfRange), true)
+}
+
+func TestDoubleLinkedList_TryGet(t *testing.T) {
+	// Arrange
+	list := NewDoubleLinkedList(1)
+	list.Add(2)
+	// Act
+	value, ok := list.TryGet(1)
+	_, outOfRange := list.TryGet(-1)
+	// Assert
+	util.SimpleAssert(t, value, 2)
+	util.SimpleAssert(t, ok, true)
+	util.SimpleAssert(t, outOfRange, false)
+}
+
+func TestDoubleLinkedList_InsertE(t *testing.T) {
+	// Arrange
+	list := EmptyDoubleLinkedList[int]()
+	// Act
+	err := list.InsertE(1, 0)
+	badErr := list.InsertE(2, 2)
+	// Assert
+	util.SimpleAssert(t, err, nil)
+	util.SimpleAssert(t, errors.Is(badErr, ErrIndexOutOfRange), true)
+	util.SimpleAssert(t, list.Count, 1)
+}
+
+func TestDoubleLinkedList_RemoveEReturnsEmptyError(t *testing.T) {
+	// Arrange
+	list := EmptyDoubleLinkedList[int]()
+	// Act
+	_, err := list.RemoveE()
+	_, lastErr := list.RemoveLastE()
+	_, atErr := list.RemoveAtE(0)
+	_, getErr := list.GetE(0)
+	// Assert
+	util.SimpleAssert(t, errors.Is(err, ErrEmpty), true)
+	util.SimpleAssert(t, errors.Is(lastErr, ErrEmpty), true)
+	util.SimpleAssert(t, errors.Is(atErr, ErrIndexOutOfRange), true)
+	util.SimpleAssert(t, errors.Is(getErr, ErrIndexOutOfRange), true)
+}
+
+func TestDoubleLinkedList_RemoveE(t *testing.T) {
+	// Arrange
+	list := NewDoubleLinkedList(1)
+	list.Add(2)
+	// Act
+	first, err := list.RemoveE()
+	last, lastErr := list.RemoveLastE()
+	// Assert
+	util.SimpleAssert(t, first, 1)
+	util.SimpleAssert(t, last, 2)
+	util.SimpleAssert(t, err, nil)
+	util.SimpleAssert(t, lastErr, nil)
+	util.SimpleAssert(t, list.Count, 0)
+}
+
+func TestDoubleLinkedList_PushOnEmptyList(t *testing.T) {
+	// Arrange
+	list := EmptyDoubleLinkedList[int]()
+	expected := 1
+	// Act
+	list.Push(expected)
+	// Assert
+	util.SimpleAssert(t, list.Head.Value, expected)
+	util.SimpleAssert(t, list.Tail.Value, expected)
+	util.SimpleAssert(t, list.Count, 1)
+}
@@ -0,0 +1,145 @@
+package linked_list
+
+// Iterable is anything that can walk its values in order, stopping early when fn returns false.
+// Both list types, stack.Stack and queue.Queue implement it, so the helpers below work on all of them.
+type Iterable[T any] interface {
+	Each(fn func(index int, value T) bool)
+}
+
+// Reduce combines every value into one, starting from initial and calling fn with the result so far and the next value
+//
+// Big-O is O(n) because it visits every value once
+func Reduce[T, A any](it Iterable[T], initial A, fn func(A, T) A) A {
+	result := initial
+	it.Each(func(_ int, value T) bool {
+		result = fn(result, value)
+		return true
+	})
+	return result
+}
+
+// FindFunc returns the first value the predicate returns true for
+//
+// Returns the value and true, or the zero value and false if none matched.
+//
+// Big-O is O(n) because it might have to visit every value
+func FindFunc[T any](it Iterable[T], predicate func(T) bool) (T, bool) {
+	var found T
+	ok := false
+	it.Each(func(_ int, value T) bool {
+		if predicate(value) {
+			found, ok = value, true
+			return false
+		}
+		return true
+	})
+	return found, ok
+}
+
+// IndexFunc returns the index of the first value the predicate returns true for
+//
+// Returns the index if found, -1 otherwise.
+//
+// Big-O is O(n) because it might have to visit every value
+func IndexFunc[T any](it Iterable[T], predicate func(T) bool) int {
+	found := -1
+	it.Each(func(index int, value T) bool {
+		if predicate(value) {
+			found = index
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// ContainsFunc returns true if the predicate returns true for any value
+//
+// Big-O is O(n) because it might have to visit every value
+func ContainsFunc[T any](it Iterable[T], predicate func(T) bool) bool {
+	return IndexFunc(it, predicate) != -1
+}
+
+// Map creates a new list with fn applied to every value of s, in the same order
+//
+// Big-O is O(n) because it visits every value once, and Add is O(1)
+func Map[T, U any](s *SingleLinkedList[T], fn func(T) U) SingleLinkedList[U] {
+	result := EmptySingleLinkedList[U]()
+	for currentNode := s.Head; currentNode != nil; currentNode = currentNode.Next {
+		result.Add(fn(currentNode.Value))
+	}
+	return result
+}
+
+// MapDouble creates a new list with fn applied to every value of s, in the same order
+//
+// Big-O is O(n) because it visits every value once, and Add is O(1)
+func MapDouble[T, U any](s *DoubleLinkedList[T], fn func(T) U) DoubleLinkedList[U] {
+	result := EmptyDoubleLinkedList[U]()
+	for currentNode := s.Head; currentNode != nil; currentNode = currentNode.Next {
+		result.Add(fn(currentNode.Value))
+	}
+	return result
+}
+
+// Filter creates a new list with only the values of s the predicate returns true for, in the same order
+//
+// Big-O is O(n) because it visits every value once, and Add is O(1)
+func Filter[T any](s *SingleLinkedList[T], predicate func(T) bool) SingleLinkedList[T] {
+	result := EmptySingleLinkedList[T]()
+	for currentNode := s.Head; currentNode != nil; currentNode = currentNode.Next {
+		if predicate(currentNode.Value) {
+			result.Add(currentNode.Value)
+		}
+	}
+	return result
+}
+
+// FilterDouble creates a new list with only the values of s the predicate returns true for, in the same order
+//
+// Big-O is O(n) because it visits every value once, and Add is O(1)
+func FilterDouble[T any](s *DoubleLinkedList[T], predicate func(T) bool) DoubleLinkedList[T] {
+	result := EmptyDoubleLinkedList[T]()
+	for currentNode := s.Head; currentNode != nil; currentNode = currentNode.Next {
+		if predicate(currentNode.Value) {
+			result.Add(currentNode.Value)
+		}
+	}
+	return result
+}
+
+// RemoveIf removes every value of s the predicate returns true for, in place
+//
+// Returns the number of values removed.
+//
+// Big-O is O(n) because it walks the list once with a cursor, and each removal is O(1)
+func RemoveIf[T any](s *SingleLinkedList[T], predicate func(T) bool) int {
+	removed := 0
+	for c := s.Front(); c.Valid(); {
+		if predicate(c.Value()) {
+			c.Remove()
+			removed++
+		} else {
+			c.Next()
+		}
+	}
+	return removed
+}
+
+// RemoveIfDouble removes every value of s the predicate returns true for, in place
+//
+// Returns the number of values removed.
+//
+// Big-O is O(n) because it walks the list once with a cursor, and each removal is O(1)
+func RemoveIfDouble[T any](s *DoubleLinkedList[T], predicate func(T) bool) int {
+	removed := 0
+	for c := s.Front(); c.Valid(); {
+		if predicate(c.Value()) {
+			c.Remove()
+			removed++
+		} else {
+			c.Next()
+		}
+	}
+	return removed
+}
@@ -0,0 +1,138 @@
+package linked_list
+
+import (
+	"github.com/robertjshirts/data-structures/util"
+	"strconv"
+	"testing"
+)
+
+func isEven(value int) bool {
+	return value%2 == 0
+}
+
+func TestReduce_SumsValues(t *testing.T) {
+	// Arrange
+	list := singleOf(1, 2, 3, 4)
+	// Act
+	sum := Reduce[int](&list, 0, func(total, value int) int { return total + value })
+	// Assert
+	util.SimpleAssert(t, sum, 10)
+}
+
+func TestReduce_WorksOnDoubleLinkedList(t *testing.T) {
+	// Arrange
+	list := doubleOf(1, 2, 3)
+	// Act
+	joined := Reduce[int](&list, "", func(result string, value int) string { return result + strconv.Itoa(value) })
+	// Assert
+	util.SimpleAssert(t, joined, "123")
+}
+
+func TestFindFunc_FindsFirstMatch(t *testing.T) {
+	// Arrange
+	list := singleOf(1, 3, 4, 6)
+	// Act
+	value, ok := FindFunc[int](&list, isEven)
+	// Assert
+	util.SimpleAssert(t, value, 4)
+	util.SimpleAssert(t, ok, true)
+}
+
+func TestFindFunc_ReturnsFalseIfNotFound(t *testing.T) {
+	// Arrange
+	list := doubleOf(1, 3)
+	// Act
+	_, ok := FindFunc[int](&list, isEven)
+	// Assert
+	util.SimpleAssert(t, ok, false)
+}
+
+func TestIndexFunc_ReturnsIndex(t *testing.T) {
+	// Arrange
+	list := doubleOf(1, 3, 4, 6)
+	// Act
+	index := IndexFunc[int](&list, isEven)
+	// Assert
+	util.SimpleAssert(t, index, 2)
+}
+
+func TestContainsFunc(t *testing.T) {
+	// Arrange
+	list := singleOf(1, 3)
+	// Act
+	contains := ContainsFunc[int](&list, isEven)
+	// Assert
+	util.SimpleAssert(t, contains, false)
+}
+
+func TestMap_ChangesType(t *testing.T) {
+	// Arrange
+	list := singleOf(1, 2, 3)
+	// Act
+	result := Map(&list, strconv.Itoa)
+	// Assert
+	util.SimpleAssert(t, result.ToString(), "1 2 3 \n")
+	util.SimpleAssert(t, result.Tail.Value, "3")
+	util.SimpleAssert(t, result.Count, 3)
+}
+
+func TestMapDouble_ChangesType(t *testing.T) {
+	// Arrange
+	list := doubleOf(1, 2, 3)
+	// Act
+	result := MapDouble(&list, func(value int) float64 { return float64(value) / 2 })
+	// Assert
+	util.SimpleAssert(t, result.ToString(), "0.5 1 1.5")
+}
+
+func TestFilter_KeepsMatches(t *testing.T) {
+	// Arrange
+	list := singleOf(1, 2, 3, 4)
+	// Act
+	result := Filter(&list, isEven)
+	// Assert
+	assertSingle(t, &result, "2 4 \n")
+	assertSingle(t, &list, "1 2 3 4 \n")
+}
+
+func TestFilterDouble_KeepsMatches(t *testing.T) {
+	// Arrange
+	list := doubleOf(1, 2, 3, 4)
+	// Act
+	result := FilterDouble(&list, isEven)
+	// Assert
+	assertDouble(t, &result, "2 4")
+}
+
+func TestRemoveIf_RemovesMatches(t *testing.T) {
+	// Arrange
+	list := singleOf(2, 1, 2, 3, 4)
+	// Act
+	removed := RemoveIf(&list, isEven)
+	// Assert
+	util.SimpleAssert(t, removed, 3)
+	assertSingle(t, &list, "1 3 \n")
+}
+
+func TestRemoveIfDouble_RemovesEverything(t *testing.T) {
+	// Arrange
+	list := doubleOf(2, 4)
+	// Act
+	removed := RemoveIfDouble(&list, isEven)
+	// Assert
+	util.SimpleAssert(t, removed, 2)
+	assertDouble(t, &list, "")
+}
+
+func TestSingleLinkedList_EachStopsEarly(t *testing.T) {
+	// Arrange
+	list := singleOf(1, 2, 3)
+	calls := 0
+	// Act
+	list.Each(func(index int, value int) bool {
+		calls++
+		return index < 1
+	})
+	// Assert
+	util.SimpleAssert(t, calls, 2)
+}
@@ -0,0 +1,292 @@
+package linked_list
+
+import "fmt"
+
+// Link holds the pointers that put a value in an IntrusiveList. Embed it in your struct, parameterized by a pointer to that struct:
+//
+//	type task struct {
+//		linked_list.Link[*task]
+//		name string
+//	}
+//
+// The list then links your structs together directly, so adding and removing never allocates.
+// A value can only be in one list at a time per embedded Link.
+type Link[E any] struct {
+	next  *Link[E]
+	prev  *Link[E]
+	owner E
+	list  any
+}
+
+// link returns the Link itself. It's promoted to any struct embedding Link, which is how that struct satisfies Linkable
+func (l *Link[E]) link() *Link[E] {
+	return l
+}
+
+// Linkable is satisfied by pointers to structs that embed Link
+type Linkable[E any] interface {
+	link() *Link[E]
+}
+
+// IntrusiveList is a doubly linked list of values that carry their own Link, so it never allocates nodes.
+// The zero value is an empty list ready to use. Don't copy a list once it has values, because the values point back to it.
+type IntrusiveList[E Linkable[E]] struct {
+	head  *Link[E]
+	tail  *Link[E]
+	Count int
+}
+
+// NewIntrusiveList creates an empty list. It returns a pointer because values remember which list they're in
+//
+// Returns a pointer to a list with no head or tail and a count of 0.
+//
+// Big-O is O(1) because we're just instantiating the struct
+func NewIntrusiveList[E Linkable[E]]() *IntrusiveList[E] {
+	return &IntrusiveList[E]{
+		head:  nil,
+		tail:  nil,
+		Count: 0,
+	}
+}
+
+// Front returns the first value in the list
+//
+// Returns the value and true, or the zero value and false if the list is empty.
+//
+// Big-O is O(1) because we keep a pointer to the head
+func (s *IntrusiveList[E]) Front() (E, bool) {
+	return ownerOf(s.head)
+}
+
+// Back returns the last value in the list
+//
+// Returns the value and true, or the zero value and false if the list is empty.
+//
+// Big-O is O(1) because we keep a pointer to the tail
+func (s *IntrusiveList[E]) Back() (E, bool) {
+	return ownerOf(s.tail)
+}
+
+// Next returns the value after value in the list
+//
+// Returns the value and true, or the zero value and false if value is the last one.
+//
+// Panics if value isn't in this list.
+//
+// Big-O is O(1) because we just follow the next pointer
+func (s *IntrusiveList[E]) Next(value E) (E, bool) {
+	return ownerOf(s.member(value).next)
+}
+
+// Prev returns the value before value in the list
+//
+// Returns the value and true, or the zero value and false if value is the first one.
+//
+// Panics if value isn't in this list.
+//
+// Big-O is O(1) because we just follow the previous pointer
+func (s *IntrusiveList[E]) Prev(value E) (E, bool) {
+	return ownerOf(s.member(value).prev)
+}
+
+// Contains returns true if value is in this list
+//
+// Big-O is O(1) because every Link remembers which list it's in
+func (s *IntrusiveList[E]) Contains(value E) bool {
+	return value.link().list == any(s)
+}
+
+// PushFront adds a value to the start of the list
+//
+// Panics if value is already in a list.
+//
+// Big-O is O(1) because we're just reassigning the head
+func (s *IntrusiveList[E]) PushFront(value E) {
+	s.insert(value, nil, s.head)
+}
+
+// PushBack adds a value to the end of the list
+//
+// Panics if value is already in a list.
+//
+// Big-O is O(1) because we're just reassigning the tail
+func (s *IntrusiveList[E]) PushBack(value E) {
+	s.insert(value, s.tail, nil)
+}
+
+// InsertAfter adds a value right after mark
+//
+// Panics if value is already in a list, or if mark isn't in this list.
+//
+// Big-O is O(1) because we just relink mark and its next node
+func (s *IntrusiveList[E]) InsertAfter(value, mark E) {
+	markLink := s.member(mark)
+	s.insert(value, markLink, markLink.next)
+}
+
+// InsertBefore adds a value right before mark
+//
+// Panics if value is already in a list, or if mark isn't in this list.
+//
+// Big-O is O(1) because we just relink mark and its previous node
+func (s *IntrusiveList[E]) InsertBefore(value, mark E) {
+	markLink := s.member(mark)
+	s.insert(value, markLink.prev, markLink)
+}
+
+// Remove takes a value out of the list, so it can be added to a list again
+//
+// Panics if value isn't in this list.
+//
+// Big-O is O(1) because the value's Link already knows its neighbors
+func (s *IntrusiveList[E]) Remove(value E) {
+	removed := s.member(value)
+	if removed.prev == nil {
+		s.head = removed.next
+	} else {
+		removed.prev.next = removed.next
+	}
+	if removed.next == nil {
+		s.tail = removed.prev
+	} else {
+		removed.next.prev = removed.prev
+	}
+
+	*removed = Link[E]{}
+	s.Count--
+}
+
+// PopFront removes the first value and returns it
+//
+// Returns the value and true, or the zero value and false if the list is empty.
+//
+// Big-O is O(1) because s.Remove is O(1)
+func (s *IntrusiveList[E]) PopFront() (E, bool) {
+	value, ok := s.Front()
+	if ok {
+		s.Remove(value)
+	}
+	return value, ok
+}
+
+// PopBack removes the last value and returns it
+//
+// Returns the value and true, or the zero value and false if the list is empty.
+//
+// Big-O is O(1) because s.Remove is O(1)
+func (s *IntrusiveList[E]) PopBack() (E, bool) {
+	value, ok := s.Back()
+	if ok {
+		s.Remove(value)
+	}
+	return value, ok
+}
+
+// Each calls fn with the index and value of each node, from head to tail.
+// Stops early if fn returns false. fn may remove the value it was called with.
+//
+// Big-O is O(n) because it will have to iterate through the list
+func (s *IntrusiveList[E]) Each(fn func(index int, value E) bool) {
+	currentLink := s.head
+	for i := 0; currentLink != nil; i++ {
+		// Grab the next link first, in case fn removes this one
+		nextLink := currentLink.next
+		if !fn(i, currentLink.owner) {
+			return
+		}
+		currentLink = nextLink
+	}
+}
+
+// EachReverse calls fn with the index and value of each node, from tail to head.
+// Stops early if fn returns false. fn may remove the value it was called with.
+//
+// Big-O is O(n) because it will have to iterate through the list
+func (s *IntrusiveList[E]) EachReverse(fn func(index int, value E) bool) {
+	currentLink := s.tail
+	for i := s.Count - 1; currentLink != nil; i-- {
+		// Grab the previous link first, in case fn removes this one
+		prevLink := currentLink.prev
+		if !fn(i, currentLink.owner) {
+			return
+		}
+		currentLink = prevLink
+	}
+}
+
+// Validate checks that the list's head, tail and Count agree with its links, that every prev pointer matches a next pointer,
+// and that every link belongs to this list and to the value it holds
+//
+// Returns nil if the list is valid, or an error describing the first problem found.
+//
+// Big-O is O(n) because it walks the whole list
+func (s *IntrusiveList[E]) Validate() error {
+	count := 0
+	var lastLink *Link[E]
+	for currentLink := s.head; currentLink != nil; currentLink = currentLink.next {
+		if currentLink.prev != lastLink {
+			return fmt.Errorf("node %d has the wrong previous node", count)
+		}
+		if currentLink.list != any(s) {
+			return fmt.Errorf("node %d belongs to a different list", count)
+		}
+		if currentLink.owner.link() != currentLink {
+			return fmt.Errorf("node %d holds a value whose link is somewhere else", count)
+		}
+		count++
+		lastLink = currentLink
+		// A cycle would make the list go on forever, so stop once we've gone past Count
+		if count > s.Count {
+			return fmt.Errorf("list has more than %d nodes", s.Count)
+		}
+	}
+
+	if count != s.Count {
+		return fmt.Errorf("count is %d, but the list has %d nodes", s.Count, count)
+	}
+	if lastLink != s.tail {
+		return fmt.Errorf("tail is not the last node")
+	}
+	return nil
+}
+
+// insert links value in between prevLink and nextLink, either of which can be nil at the ends of the list
+func (s *IntrusiveList[E]) insert(value E, prevLink, nextLink *Link[E]) {
+	newLink := value.link()
+	if newLink.list != nil {
+		panic("Value is already in a list")
+	}
+
+	newLink.owner = value
+	newLink.list = s
+	newLink.prev = prevLink
+	newLink.next = nextLink
+	if prevLink == nil {
+		s.head = newLink
+	} else {
+		prevLink.next = newLink
+	}
+	if nextLink == nil {
+		s.tail = newLink
+	} else {
+		nextLink.prev = newLink
+	}
+	s.Count++
+}
+
+// member returns value's Link, panicking if it isn't in this list
+func (s *IntrusiveList[E]) member(value E) *Link[E] {
+	if !s.Contains(value) {
+		panic("Value is not in this list")
+	}
+	return value.link()
+}
+
+// ownerOf returns the value holding a Link, and false if the Link is nil
+func ownerOf[E any](l *Link[E]) (E, bool) {
+	if l == nil {
+		var zero E
+		return zero, false
+	}
+	return l.owner, true
+}
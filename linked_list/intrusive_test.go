@@ -0,0 +1,206 @@
+package linked_list
+
+import (
+	"testing"
+
+	"github.com/robertjshirts/data-structures/util"
+)
+
+type task struct {
+	Link[*task]
+	id int
+}
+
+func intrusiveOf(tasks ...*task) *IntrusiveList[*task] {
+	list := NewIntrusiveList[*task]()
+	for _, t := range tasks {
+		list.PushBack(t)
+	}
+	return list
+}
+
+func assertIntrusive(t *testing.T, list *IntrusiveList[*task], expected ...int) {
+	t.Helper()
+	if err := list.Validate(); err != nil {
+		t.Errorf("Expected a valid list, got %v", err)
+	}
+	var ids []int
+	list.Each(func(_ int, value *task) bool {
+		ids = append(ids, value.id)
+		return true
+	})
+	util.SimpleAssert(t, len(ids), len(expected))
+	for i := range ids {
+		util.SimpleAssert(t, ids[i], expected[i])
+	}
+}
+
+func TestIntrusiveList_ZeroValueIsUsable(t *testing.T) {
+	// Arrange
+	var list IntrusiveList[*task]
+	// Act
+	list.PushBack(&task{id: 1})
+	// Assert
+	assertIntrusive(t, &list, 1)
+}
+
+func TestIntrusiveList_PushFrontAndBack(t *testing.T) {
+	// Arrange
+	list := NewIntrusiveList[*task]()
+	// Act
+	list.PushBack(&task{id: 2})
+	list.PushFront(&task{id: 1})
+	list.PushBack(&task{id: 3})
+	// Assert
+	assertIntrusive(t, list, 1, 2, 3)
+	front, _ := list.Front()
+	back, _ := list.Back()
+	util.SimpleAssert(t, front.id, 1)
+	util.SimpleAssert(t, back.id, 3)
+}
+
+func TestIntrusiveList_InsertAfterAndBefore(t *testing.T) {
+	// Arrange
+	one, three := &task{id: 1}, &task{id: 3}
+	list := intrusiveOf(one, three)
+	// Act
+	list.InsertAfter(&task{id: 2}, one)
+	list.InsertBefore(&task{id: 0}, one)
+	list.InsertAfter(&task{id: 4}, three)
+	// Assert
+	assertIntrusive(t, list, 0, 1, 2, 3, 4)
+}
+
+func TestIntrusiveList_NextAndPrev(t *testing.T) {
+	// Arrange
+	one, two := &task{id: 1}, &task{id: 2}
+	list := intrusiveOf(one, two)
+	// Act
+	next, nextOk := list.Next(one)
+	_, lastOk := list.Next(two)
+	prev, prevOk := list.Prev(two)
+	// Assert
+	util.SimpleAssert(t, next, two)
+	util.SimpleAssert(t, nextOk, true)
+	util.SimpleAssert(t, lastOk, false)
+	util.SimpleAssert(t, prev, one)
+	util.SimpleAssert(t, prevOk, true)
+}
+
+func TestIntrusiveList_RemoveAndReuse(t *testing.T) {
+	// Arrange
+	one, two, three := &task{id: 1}, &task{id: 2}, &task{id: 3}
+	list := intrusiveOf(one, two, three)
+	other := NewIntrusiveList[*task]()
+	// Act
+	list.Remove(two)
+	other.PushBack(two)
+	// Assert
+	assertIntrusive(t, list, 1, 3)
+	assertIntrusive(t, other, 2)
+	util.SimpleAssert(t, list.Contains(two), false)
+	util.SimpleAssert(t, other.Contains(two), true)
+}
+
+func TestIntrusiveList_PopFrontAndBack(t *testing.T) {
+	// Arrange
+	list := intrusiveOf(&task{id: 1}, &task{id: 2}, &task{id: 3})
+	// Act
+	front, _ := list.PopFront()
+	back, _ := list.PopBack()
+	list.PopFront()
+	_, ok := list.PopFront()
+	// Assert
+	util.SimpleAssert(t, front.id, 1)
+	util.SimpleAssert(t, back.id, 3)
+	util.SimpleAssert(t, ok, false)
+	assertIntrusive(t, list)
+}
+
+func TestIntrusiveList_PushPanicsIfAlreadyInAList(t *testing.T) {
+	// Arrange
+	one := &task{id: 1}
+	list := intrusiveOf(one)
+	other := NewIntrusiveList[*task]()
+	util.SimpleAssert(t, list.Contains(one), true)
+	defer func() {
+		// Assert
+		if r := recover(); r == nil {
+			t.Errorf("Expected a panic")
+		}
+	}()
+	// Act
+	other.PushBack(one)
+}
+
+func TestIntrusiveList_RemovePanicsIfNotInList(t *testing.T) {
+	// Arrange
+	list := intrusiveOf(&task{id: 1})
+	defer func() {
+		// Assert
+		if r := recover(); r == nil {
+			t.Errorf("Expected a panic")
+		}
+	}()
+	// Act
+	list.Remove(&task{id: 2})
+}
+
+func TestIntrusiveList_EachCanRemove(t *testing.T) {
+	// Arrange
+	list := intrusiveOf(&task{id: 1}, &task{id: 2}, &task{id: 3}, &task{id: 4})
+	// Act
+	list.Each(func(_ int, value *task) bool {
+		if value.id%2 == 0 {
+			list.Remove(value)
+		}
+		return true
+	})
+	// Assert
+	assertIntrusive(t, list, 1, 3)
+}
+
+func TestIntrusiveList_EachReverse(t *testing.T) {
+	// Arrange
+	list := intrusiveOf(&task{id: 1}, &task{id: 2}, &task{id: 3})
+	var ids, indexes []int
+	// Act
+	list.EachReverse(func(index int, value *task) bool {
+		ids = append(ids, value.id)
+		indexes = append(indexes, index)
+		return value.id != 2
+	})
+	// Assert
+	util.SimpleAssert(t, len(ids), 2)
+	util.SimpleAssert(t, ids[0], 3)
+	util.SimpleAssert(t, indexes[0], 2)
+	util.SimpleAssert(t, ids[1], 2)
+	util.SimpleAssert(t, indexes[1], 1)
+}
+
+func TestIntrusiveList_DoesNotAllocate(t *testing.T) {
+	// Arrange
+	list := NewIntrusiveList[*task]()
+	tasks := []*task{{id: 1}, {id: 2}, {id: 3}}
+	// Act
+	allocs := testing.AllocsPerRun(100, func() {
+		for _, value := range tasks {
+			list.PushBack(value)
+		}
+		for list.Count > 0 {
+			list.PopFront()
+		}
+	})
+	// Assert
+	util.SimpleAssert(t, allocs, 0.0)
+}
+
+func TestIntrusiveList_ValidateCatchesWrongCount(t *testing.T) {
+	// Arrange
+	list := intrusiveOf(&task{id: 1}, &task{id: 2})
+	list.Count = 3
+	// Act
+	err := list.Validate()
+	// Assert
+	util.SimpleAssert(t, err != nil, true)
+}
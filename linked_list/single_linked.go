@@ -2,24 +2,26 @@ package linked_list
 
 import "fmt"
 
-type node[T comparable] struct {
+type node[T any] struct {
 	Value T
 	Next  *node[T]
 }
 
-type SingleLinkedList[T comparable] struct {
+type SingleLinkedList[T any] struct {
 	Head  *node[T]
+	Tail  *node[T]
 	Count int
 }
 
 // EmptySingleLinkedList creates an empty list with no head
 //
-// # Returns a list with no head and a count of 0
+// # Returns a list with no head or tail and a count of 0
 //
 // Big-O is O(1) because we're just instantiating a new node
-func EmptySingleLinkedList[T comparable]() SingleLinkedList[T] {
+func EmptySingleLinkedList[T any]() SingleLinkedList[T] {
 	return SingleLinkedList[T]{
 		Head:  nil,
+		Tail:  nil,
 		Count: 0,
 	}
 }
@@ -29,18 +31,19 @@ func EmptySingleLinkedList[T comparable]() SingleLinkedList[T] {
 // # Returns a list with a single node with the value of firstItem
 //
 // Big-O is O(1) because we're just instantiating a new node
-func NewSingleLinkedList[T comparable](firstItem T) SingleLinkedList[T] {
+func NewSingleLinkedList[T any](firstItem T) SingleLinkedList[T] {
 	Head := node[T]{
 		Value: firstItem,
 		Next:  nil,
 	}
 	return SingleLinkedList[T]{
 		Head:  &Head,
+		Tail:  &Head,
 		Count: 1,
 	}
 }
 
-// Push adds an item to the start of the list
+// Push adds an item to the start of the list. Will reassign tail if the list is empty.
 //
 // Big-O is O(1) because it's just reassigning the head
 func (s *SingleLinkedList[T]) Push(item T) {
@@ -48,13 +51,16 @@ func (s *SingleLinkedList[T]) Push(item T) {
 		Value: item,
 		Next:  s.Head,
 	}
+	if s.Head == nil {
+		s.Tail = &newNode
+	}
 	s.Head = &newNode
 	s.Count++
 }
 
-// Add adds a value to the end of the list. Will reassign head if it's nil.
+// Add adds a value to the end of the list. Will reassign head and tail if they're nil.
 //
-// Big-O is O(n) because it calls s.GetNode which is O(n) and the rest is just changing around pointers
+// Big-O is O(1) because we just change the tail pointers around
 func (s *SingleLinkedList[T]) Add(item T) {
 	newNode := node[T]{
 		Value: item,
@@ -63,12 +69,13 @@ func (s *SingleLinkedList[T]) Add(item T) {
 
 	if s.Head == nil {
 		s.Head = &newNode
+		s.Tail = &newNode
 		s.Count = 1
 		return
 	}
 
-	lastNode := s.GetNode(s.Count - 1)
-	lastNode.Next = &newNode
+	s.Tail.Next = &newNode
+	s.Tail = &newNode
 	s.Count++
 }
 
@@ -85,10 +92,13 @@ func (s *SingleLinkedList[T]) Insert(value T, index int) {
 
 	// If insert at index 0, change head
 	if index == 0 {
-		s.Head = &node[T]{
-			Value: value,
-			Next:  s.Head,
-		}
+		s.Push(value)
+		return
+	}
+
+	// If insert at the end, change tail
+	if index == s.Count {
+		s.Add(value)
 		return
 	}
 
@@ -131,6 +141,10 @@ func (s *SingleLinkedList[T]) Remove() T {
 	}
 	head := *s.Head
 	s.Head = head.Next
+	// If the list is now empty, the tail has to go too
+	if s.Head == nil {
+		s.Tail = nil
+	}
 	s.Count--
 	return head.Value
 }
@@ -152,7 +166,14 @@ func (s *SingleLinkedList[T]) RemoveAt(index int) T {
 
 	beforeNode := s.GetNode(index - 1)
 	removedNode := beforeNode.Next
+	if removedNode == nil {
+		panic("Index out of bounds!")
+	}
 	beforeNode.Next = removedNode.Next
+	// If we removed the tail, the node before it is the new tail
+	if removedNode == s.Tail {
+		s.Tail = beforeNode
+	}
 	s.Count--
 
 	return removedNode.Value
@@ -164,7 +185,8 @@ func (s *SingleLinkedList[T]) RemoveAt(index int) T {
 //
 // # Panics if the list is empty
 //
-// Big-O is O(n) because it calls s.RemoveAt which is O(n)
+// Big-O is O(n) because it calls s.RemoveAt which is O(n).
+// The tail pointer doesn't help here, because we still have to find the node before it
 func (s *SingleLinkedList[T]) RemoveLast() T {
 	// Don't decrement bc RemoveAt does it
 	if s.Count == 0 {
@@ -173,23 +195,49 @@ func (s *SingleLinkedList[T]) RemoveLast() T {
 	return s.RemoveAt(s.Count - 1)
 }
 
-// Clear clears the list by setting the head to nil and count to 0
+// Clear clears the list by setting the head and tail to nil and count to 0
 //
-// Big-O is O(1) because it's just reassigning the head and count
+// Big-O is O(1) because it's just reassigning the head, tail and count
 func (s *SingleLinkedList[T]) Clear() {
 	s.Head = nil
+	s.Tail = nil
 	s.Count = 0
 }
 
-// Search searches for a value in the list and returns its index
+// Last returns the value at the end of the list
+//
+// # Returns the value of the tail node
+//
+// # Panics if the list is empty
+//
+// Big-O is O(1) because we keep a pointer to the tail
+func (s *SingleLinkedList[T]) Last() T {
+	if s.Tail == nil {
+		panic("No elements in list")
+	}
+	return s.Tail.Value
+}
+
+// Search searches for a value in the list and returns its index.
+// It's a function instead of a method because only comparable values can be checked with ==.
+// Like Sort and Map, the single list version is unsuffixed and the double list one is SearchDouble
+//
+// # Returns the index of the value if found, -1 if not found
+//
+// Big-O is O(n) because SearchFunc is O(n)
+func Search[T comparable](s *SingleLinkedList[T], value T) int {
+	return s.SearchFunc(func(other T) bool { return other == value })
+}
+
+// SearchFunc searches for the first value that match returns true for and returns its index
 //
 // # Returns the index of the value if found, -1 if not found
 //
 // Big-O is O(n) because it will have to for loop through each
-func (s *SingleLinkedList[T]) Search(value T) int {
+func (s *SingleLinkedList[T]) SearchFunc(match func(T) bool) int {
 	currentNode := s.Head
 	for i := 0; i < s.Count; i++ {
-		if currentNode.Value == value {
+		if match(currentNode.Value) {
 			// If found, return the index
 			return i
 		}
@@ -222,6 +270,20 @@ func (s *SingleLinkedList[T]) GetNode(index int) *node[T] {
 	return currentNode
 }
 
+// Each calls fn with the index and value of each node, from head to tail.
+// Stops early if fn returns false.
+//
+// Big-O is O(n) because it will have to iterate through the list
+func (s *SingleLinkedList[T]) Each(fn func(index int, value T) bool) {
+	currentNode := s.Head
+	for i := 0; currentNode != nil; i++ {
+		if !fn(i, currentNode.Value) {
+			return
+		}
+		currentNode = currentNode.Next
+	}
+}
+
 // ToString converts the list to a string
 //
 // Returns a string representation of the list in the following format: "1 2 3 4".
@@ -238,3 +300,104 @@ func (s *SingleLinkedList[T]) ToString() string {
 	result += "\n"
 	return result
 }
+
+// TryGet returns the value at the specified index
+//
+// # Returns the value and true, or the zero value and false if the index is out of bounds
+//
+// Big-O is O(n) because s.GetNode is O(n)
+func (s *SingleLinkedList[T]) TryGet(index int) (T, bool) {
+	value, err := s.GetE(index)
+	return value, err == nil
+}
+
+// GetE returns the value at the specified index
+//
+// # Returns ErrIndexOutOfRange if the index is out of bounds
+//
+// Big-O is O(n) because s.GetNode is O(n)
+func (s *SingleLinkedList[T]) GetE(index int) (T, error) {
+	node, err := s.GetNodeE(index)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return node.Value, nil
+}
+
+// GetNodeE finds and returns the node at the specified index
+//
+// # Returns ErrIndexOutOfRange if the index is out of bounds
+//
+// Big-O is O(n) because s.GetNode is O(n)
+func (s *SingleLinkedList[T]) GetNodeE(index int) (*node[T], error) {
+	if index < 0 || index >= s.Count {
+		return nil, outOfRange(index, s.Count)
+	}
+	return s.GetNode(index), nil
+}
+
+// InsertE inserts a value at the specified index
+//
+// # Returns ErrIndexOutOfRange if the index is out of bounds
+//
+// Big-O is O(n) because s.Insert is O(n)
+func (s *SingleLinkedList[T]) InsertE(value T, index int) error {
+	if index < 0 || index > s.Count {
+		return outOfRange(index, s.Count)
+	}
+	s.Insert(value, index)
+	return nil
+}
+
+// RemoveE removes the head node and returns its value
+//
+// # Returns ErrEmpty if the list is empty
+//
+// Big-O is O(1) because s.Remove is O(1)
+func (s *SingleLinkedList[T]) RemoveE() (T, error) {
+	if s.Count == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return s.Remove(), nil
+}
+
+// RemoveAtE removes the node at the specified index and returns its value
+//
+// # Returns ErrIndexOutOfRange if the index is out of bounds
+//
+// Big-O is O(n) because s.RemoveAt is O(n)
+func (s *SingleLinkedList[T]) RemoveAtE(index int) (T, error) {
+	if index < 0 || index >= s.Count {
+		var zero T
+		return zero, outOfRange(index, s.Count)
+	}
+	return s.RemoveAt(index), nil
+}
+
+// RemoveLastE removes the last node and returns its value
+//
+// # Returns ErrEmpty if the list is empty
+//
+// Big-O is O(n) because s.RemoveLast is O(n)
+func (s *SingleLinkedList[T]) RemoveLastE() (T, error) {
+	if s.Count == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return s.RemoveLast(), nil
+}
+
+// LastE returns the value at the end of the list
+//
+// # Returns ErrEmpty if the list is empty
+//
+// Big-O is O(1) because we keep a pointer to the tail
+func (s *SingleLinkedList[T]) LastE() (T, error) {
+	if s.Count == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return s.Last(), nil
+}
@@ -253,7 +253,7 @@ func TestSingleLinkedList_SearchReturnsCorrectIndex(t *testing.T) {
 	list.Add(search)
 	list.Add(3)
 	// Act
-	actual := list.Search(search)
+	actual := Search(&list, search)
 	// Assert
 	util.SimpleAssert(t, actual, expected)
 }
@@ -266,7 +266,149 @@ func TestSingleLinkedList_SearchReturnsMinusOneIfNotFound(t *testing.T) {
 	list.Add(2)
 	list.Add(3)
 	// Act
-	actual := list.Search(search)
+	actual := Search(&list, search)
 	// Assert
 	util.SimpleAssert(t, actual, expected)
 }
+
+func TestSingleLinkedList_AddChangesTail(t *testing.T) {
+	// Arrange
+	list := EmptySingleLinkedList[int]()
+	expected := 3
+	// Act
+	list.Add(1)
+	list.Add(2)
+	list.Add(expected)
+	// Assert
+	util.SimpleAssert(t, list.Tail.Value, expected)
+	util.NilAssert(t, list.Tail.Next)
+}
+
+func TestSingleLinkedList_PushOnEmptyListSetsTail(t *testing.T) {
+	// Arrange
+	list := EmptySingleLinkedList[int]()
+	expected := 1
+	// Act
+	list.Push(expected)
+	list.Push(0)
+	// Assert
+	util.SimpleAssert(t, list.Tail.Value, expected)
+}
+
+func TestSingleLinkedList_InsertAtZeroChangesCount(t *testing.T) {
+	// Arrange
+	list := NewSingleLinkedList(1)
+	list.Add(2)
+	expected := 3
+	// Act
+	list.Insert(0, 0)
+	// Assert
+	util.SimpleAssert(t, list.Count, expected)
+}
+
+func TestSingleLinkedList_InsertAtEndChangesTail(t *testing.T) {
+	// Arrange
+	list := NewSingleLinkedList(1)
+	list.Add(2)
+	expected := 3
+	// Act
+	list.Insert(expected, 2)
+	// Assert
+	util.SimpleAssert(t, list.Tail.Value, expected)
+}
+
+func TestSingleLinkedList_RemoveLastChangesTail(t *testing.T) {
+	// Arrange
+	list := NewSingleLinkedList(1)
+	list.Add(2)
+	list.Add(3)
+	expected := 2
+	// Act
+	list.RemoveLast()
+	// Assert
+	util.SimpleAssert(t, list.Tail.Value, expected)
+	util.NilAssert(t, list.Tail.Next)
+}
+
+func TestSingleLinkedList_RemoveOnlyNodeClearsTail(t *testing.T) {
+	// Arrange
+	list := NewSingleLinkedList(1)
+	// Act
+	list.Remove()
+	// Assert
+	util.NilAssert(t, list.Tail)
+}
+
+func TestSingleLinkedList_ClearClearsTail(t *testing.T) {
+	// Arrange
+	list := NewSingleLinkedList(1)
+	list.Add(2)
+	// Act
+	list.Clear()
+	// Assert
+	util.NilAssert(t, list.Tail)
+}
+
+func TestSingleLinkedList_AddAfterRemoveLast(t *testing.T) {
+	// Arrange
+	list := NewSingleLinkedList(1)
+	list.Add(2)
+	list.RemoveLast()
+	expected := "1 3 \n"
+	// Act
+	list.Add(3)
+	// Assert
+	util.SimpleAssert(t, list.ToString(), expected)
+}
+
+func TestSingleLinkedList_Last(t *testing.T) {
+	// Arrange
+	list := NewSingleLinkedList(1)
+	expected := 2
+	list.Add(expected)
+	// Act
+	actual := list.Last()
+	// Assert
+	util.SimpleAssert(t, actual, expected)
+}
+
+func TestSingleLinkedList_LastPanicsOnEmptyList(t *testing.T) {
+	// Arrange
+	list := EmptySingleLinkedList[int]()
+	defer func() {
+		// Assert
+		if r := recover(); r == nil {
+			t.Errorf("The code did not panic")
+		}
+	}()
+	// Act
+	list.Last()
+}
+
+func BenchmarkSingleLinkedList_Add(b *testing.B) {
+	list := EmptySingleLinkedList[int]()
+	for i := 0; i < b.N; i++ {
+		list.Add(i)
+	}
+}
+
+func TestSingleLinkedList_HoldsSlices(t *testing.T) {
+	// Arrange
+	list := EmptySingleLinkedList[[]byte]()
+	list.Add([]byte("a"))
+	list.Add([]byte("bc"))
+	// Act
+	actual := list.SearchFunc(func(value []byte) bool { return string(value) == "bc" })
+	// Assert
+	util.SimpleAssert(t, actual, 1)
+	util.SimpleAssert(t, string(list.Last()), "bc")
+}
+
+func TestSingleLinkedList_SearchFuncReturnsMinusOneIfNotFound(t *testing.T) {
+	// Arrange
+	list := NewSingleLinkedList(1)
+	// Act
+	actual := list.SearchFunc(func(value int) bool { return value > 1 })
+	// Assert
+	util.SimpleAssert(t, actual, -1)
+}
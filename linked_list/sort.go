@@ -0,0 +1,282 @@
+package linked_list
+
+import "cmp"
+
+// SortFunc sorts the list in place with a merge sort. compare returns a negative number if a comes before b,
+// a positive number if a comes after b, and 0 if their order doesn't matter.
+// The sort is stable, so equal values keep their order.
+//
+// Nodes are relinked, not copied, so cursors stay on the same values.
+//
+// Big-O is O(n log n) because the list is halved log n times, and each level of merging is O(n)
+func (s *SingleLinkedList[T]) SortFunc(compare func(a, b T) int) {
+	s.Head = sortNodes(s.Head, s.Count, compare)
+	s.Tail = lastNode(s.Head)
+}
+
+// IsSortedFunc returns true if every value is in order according to compare
+//
+// Big-O is O(n) because it compares each pair of neighbors once
+func (s *SingleLinkedList[T]) IsSortedFunc(compare func(a, b T) int) bool {
+	for currentNode := s.Head; currentNode != nil && currentNode.Next != nil; currentNode = currentNode.Next {
+		if compare(currentNode.Value, currentNode.Next.Value) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// InsertSortedFunc inserts a value into a sorted list, after any values equal to it, so the list stays sorted
+//
+// Big-O is O(n) because it walks the list to find the spot, and the insert is O(1)
+func (s *SingleLinkedList[T]) InsertSortedFunc(value T, compare func(a, b T) int) {
+	c := s.Front()
+	for c.Valid() && compare(c.Value(), value) <= 0 {
+		c.Next()
+	}
+	if c.Valid() {
+		c.InsertBefore(value)
+	} else {
+		s.Add(value)
+	}
+}
+
+// MergeFunc merges the nodes of other, a sorted list, into this sorted list, leaving other empty.
+// When values are equal, the ones from this list come first.
+//
+// # Panics if other is the same list
+//
+// Big-O is O(n + m) because each node is looked at once
+func (s *SingleLinkedList[T]) MergeFunc(other *SingleLinkedList[T], compare func(a, b T) int) {
+	if other == s {
+		panic("Can't merge a list with itself")
+	}
+	s.Head = mergeNodes(s.Head, other.Head, compare)
+	// Ties go to our nodes first, so other's tail is last unless ours is bigger
+	if s.Tail == nil || (other.Tail != nil && compare(other.Tail.Value, s.Tail.Value) >= 0) {
+		s.Tail = other.Tail
+	}
+	s.Count += other.Count
+	other.Clear()
+}
+
+// Sort sorts the list in place, from smallest to largest. See SortFunc
+//
+// Big-O is O(n log n) because SortFunc is O(n log n)
+func Sort[T cmp.Ordered](s *SingleLinkedList[T]) {
+	s.SortFunc(cmp.Compare[T])
+}
+
+// IsSorted returns true if the list is sorted from smallest to largest
+//
+// Big-O is O(n) because IsSortedFunc is O(n)
+func IsSorted[T cmp.Ordered](s *SingleLinkedList[T]) bool {
+	return s.IsSortedFunc(cmp.Compare[T])
+}
+
+// InsertSorted inserts a value into a list sorted from smallest to largest, so it stays sorted
+//
+// Big-O is O(n) because InsertSortedFunc is O(n)
+func InsertSorted[T cmp.Ordered](s *SingleLinkedList[T], value T) {
+	s.InsertSortedFunc(value, cmp.Compare[T])
+}
+
+// MergeSorted merges two lists sorted from smallest to largest into a new sorted list.
+// The nodes are moved, not copied, so a and b are left empty.
+//
+// Big-O is O(n + m) because MergeFunc is O(n + m)
+func MergeSorted[T cmp.Ordered](a, b *SingleLinkedList[T]) SingleLinkedList[T] {
+	result := EmptySingleLinkedList[T]()
+	result.Concat(a)
+	result.MergeFunc(b, cmp.Compare[T])
+	return result
+}
+
+// SortFunc sorts the list in place with a merge sort. compare returns a negative number if a comes before b,
+// a positive number if a comes after b, and 0 if their order doesn't matter.
+// The sort is stable, so equal values keep their order.
+//
+// Nodes are relinked, not copied, so cursors stay on the same values.
+//
+// Big-O is O(n log n) because the list is halved log n times, and each level of merging is O(n)
+func (s *DoubleLinkedList[T]) SortFunc(compare func(a, b T) int) {
+	s.Head = sortDoubleNodes(s.Head, s.Count, compare)
+	s.relinkPrev()
+}
+
+// IsSortedFunc returns true if every value is in order according to compare
+//
+// Big-O is O(n) because it compares each pair of neighbors once
+func (s *DoubleLinkedList[T]) IsSortedFunc(compare func(a, b T) int) bool {
+	for currentNode := s.Head; currentNode != nil && currentNode.Next != nil; currentNode = currentNode.Next {
+		if compare(currentNode.Value, currentNode.Next.Value) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// InsertSortedFunc inserts a value into a sorted list, after any values equal to it, so the list stays sorted
+//
+// Big-O is O(n) because it walks the list to find the spot, and the insert is O(1)
+func (s *DoubleLinkedList[T]) InsertSortedFunc(value T, compare func(a, b T) int) {
+	c := s.Front()
+	for c.Valid() && compare(c.Value(), value) <= 0 {
+		c.Next()
+	}
+	if c.Valid() {
+		c.InsertBefore(value)
+	} else {
+		s.Add(value)
+	}
+}
+
+// MergeFunc merges the nodes of other, a sorted list, into this sorted list, leaving other empty.
+// When values are equal, the ones from this list come first.
+//
+// Panics if other is the same list.
+//
+// Big-O is O(n + m) because each node is looked at once
+func (s *DoubleLinkedList[T]) MergeFunc(other *DoubleLinkedList[T], compare func(a, b T) int) {
+	if other == s {
+		panic("Can't merge a list with itself")
+	}
+	s.Head = mergeDoubleNodes(s.Head, other.Head, compare)
+	s.Count += other.Count
+	other.Clear()
+	s.relinkPrev()
+}
+
+// SortDouble sorts the list in place, from smallest to largest. See DoubleLinkedList.SortFunc
+//
+// Big-O is O(n log n) because SortFunc is O(n log n)
+func SortDouble[T cmp.Ordered](s *DoubleLinkedList[T]) {
+	s.SortFunc(cmp.Compare[T])
+}
+
+// IsSortedDouble returns true if the list is sorted from smallest to largest
+//
+// Big-O is O(n) because IsSortedFunc is O(n)
+func IsSortedDouble[T cmp.Ordered](s *DoubleLinkedList[T]) bool {
+	return s.IsSortedFunc(cmp.Compare[T])
+}
+
+// InsertSortedDouble inserts a value into a list sorted from smallest to largest, so it stays sorted
+//
+// Big-O is O(n) because InsertSortedFunc is O(n)
+func InsertSortedDouble[T cmp.Ordered](s *DoubleLinkedList[T], value T) {
+	s.InsertSortedFunc(value, cmp.Compare[T])
+}
+
+// MergeSortedDouble merges two lists sorted from smallest to largest into a new sorted list.
+// The nodes are moved, not copied, so a and b are left empty.
+//
+// Big-O is O(n + m) because MergeFunc is O(n + m)
+func MergeSortedDouble[T cmp.Ordered](a, b *DoubleLinkedList[T]) DoubleLinkedList[T] {
+	result := EmptyDoubleLinkedList[T]()
+	result.Concat(a)
+	result.MergeFunc(b, cmp.Compare[T])
+	return result
+}
+
+// sortNodes merge sorts a chain of count nodes, and returns the new first node
+func sortNodes[T any](head *node[T], count int, compare func(a, b T) int) *node[T] {
+	if count <= 1 {
+		return head
+	}
+
+	// Cut the chain in half
+	lastLeft := head
+	for i := 1; i < count/2; i++ {
+		lastLeft = lastLeft.Next
+	}
+	right := lastLeft.Next
+	lastLeft.Next = nil
+
+	return mergeNodes(sortNodes(head, count/2, compare), sortNodes(right, count-count/2, compare), compare)
+}
+
+// mergeNodes merges two sorted chains, taking from a first when values are equal so the merge is stable
+func mergeNodes[T any](a, b *node[T], compare func(a, b T) int) *node[T] {
+	var start node[T]
+	last := &start
+	for a != nil && b != nil {
+		if compare(b.Value, a.Value) < 0 {
+			last.Next = b
+			b = b.Next
+		} else {
+			last.Next = a
+			a = a.Next
+		}
+		last = last.Next
+	}
+	if a != nil {
+		last.Next = a
+	} else {
+		last.Next = b
+	}
+	return start.Next
+}
+
+// lastNode walks a chain to its last node
+func lastNode[T any](head *node[T]) *node[T] {
+	if head == nil {
+		return nil
+	}
+	for head.Next != nil {
+		head = head.Next
+	}
+	return head
+}
+
+// sortDoubleNodes merge sorts a chain of count nodes using only the Next pointers, and returns the new first node.
+// The Prev pointers have to be fixed afterwards with relinkPrev
+func sortDoubleNodes[T any](head *doubleNode[T], count int, compare func(a, b T) int) *doubleNode[T] {
+	if count <= 1 {
+		return head
+	}
+
+	// Cut the chain in half
+	lastLeft := head
+	for i := 1; i < count/2; i++ {
+		lastLeft = lastLeft.Next
+	}
+	right := lastLeft.Next
+	lastLeft.Next = nil
+
+	return mergeDoubleNodes(sortDoubleNodes(head, count/2, compare), sortDoubleNodes(right, count-count/2, compare), compare)
+}
+
+// mergeDoubleNodes merges two sorted chains using only the Next pointers, taking from a first when values are equal
+func mergeDoubleNodes[T any](a, b *doubleNode[T], compare func(a, b T) int) *doubleNode[T] {
+	var start doubleNode[T]
+	last := &start
+	for a != nil && b != nil {
+		if compare(b.Value, a.Value) < 0 {
+			last.Next = b
+			b = b.Next
+		} else {
+			last.Next = a
+			a = a.Next
+		}
+		last = last.Next
+	}
+	if a != nil {
+		last.Next = a
+	} else {
+		last.Next = b
+	}
+	return start.Next
+}
+
+// relinkPrev walks the list from the head, fixing every Prev pointer and the tail
+//
+// Big-O is O(n) because it visits every node once
+func (s *DoubleLinkedList[T]) relinkPrev() {
+	var prevNode *doubleNode[T]
+	for currentNode := s.Head; currentNode != nil; currentNode = currentNode.Next {
+		currentNode.Prev = prevNode
+		prevNode = currentNode
+	}
+	s.Tail = prevNode
+}
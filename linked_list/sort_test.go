@@ -0,0 +1,213 @@
+package linked_list
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/robertjshirts/data-structures/util"
+)
+
+type pair struct {
+	key   int
+	order int
+}
+
+func comparePairs(a, b pair) int {
+	return cmp.Compare(a.key, b.key)
+}
+
+func TestSingleLinkedList_Sort(t *testing.T) {
+	// Arrange
+	list := singleOf(5, 3, 8, 1, 9, 2)
+	// Act
+	Sort(&list)
+	list.Add(10)
+	// Assert
+	assertSingle(t, &list, "1 2 3 5 8 9 10 \n")
+	util.SimpleAssert(t, list.Last(), 10)
+	util.SimpleAssert(t, IsSorted(&list), true)
+}
+
+func TestSingleLinkedList_SortEmptyAndSingle(t *testing.T) {
+	// Arrange
+	empty := EmptySingleLinkedList[int]()
+	single := singleOf(1)
+	// Act
+	Sort(&empty)
+	Sort(&single)
+	// Assert
+	assertSingle(t, &empty, "\n")
+	assertSingle(t, &single, "1 \n")
+}
+
+func TestSingleLinkedList_SortFuncIsStable(t *testing.T) {
+	// Arrange
+	list := EmptySingleLinkedList[pair]()
+	for i, key := range []int{2, 1, 2, 1, 0, 2} {
+		list.Add(pair{key, i})
+	}
+	// Act
+	list.SortFunc(comparePairs)
+	// Assert
+	var got []pair
+	list.Each(func(_ int, value pair) bool {
+		got = append(got, value)
+		return true
+	})
+	util.SimpleAssert(t, slices.Equal(got, []pair{{0, 4}, {1, 1}, {1, 3}, {2, 0}, {2, 2}, {2, 5}}), true)
+}
+
+func TestSingleLinkedList_SortMatchesSlicesSort(t *testing.T) {
+	rng := rand.New(rand.NewSource(37))
+	for n := 0; n < 50; n++ {
+		// Arrange
+		values := make([]int, n)
+		for i := range values {
+			values[i] = rng.Intn(10)
+		}
+		list := singleOf(values...)
+		// Act
+		Sort(&list)
+		slices.Sort(values)
+		expected := singleOf(values...)
+		// Assert
+		assertSingle(t, &list, expected.ToString())
+	}
+}
+
+func TestSingleLinkedList_IsSorted(t *testing.T) {
+	// Arrange
+	unsorted := singleOf(1, 3, 2)
+	descending := singleOf(3, 2, 1)
+	// Assert
+	util.SimpleAssert(t, IsSorted(&unsorted), false)
+	util.SimpleAssert(t, descending.IsSortedFunc(func(a, b int) int { return cmp.Compare(b, a) }), true)
+}
+
+func TestSingleLinkedList_InsertSorted(t *testing.T) {
+	// Arrange
+	list := EmptySingleLinkedList[int]()
+	// Act
+	for _, value := range []int{4, 1, 3, 5, 0} {
+		InsertSorted(&list, value)
+	}
+	// Assert
+	assertSingle(t, &list, "0 1 3 4 5 \n")
+	util.SimpleAssert(t, list.Last(), 5)
+}
+
+func TestSingleLinkedList_MergeSorted(t *testing.T) {
+	// Arrange
+	a := singleOf(1, 4, 6)
+	b := singleOf(2, 3, 7, 8)
+	// Act
+	merged := MergeSorted(&a, &b)
+	// Assert
+	assertSingle(t, &merged, "1 2 3 4 6 7 8 \n")
+	util.SimpleAssert(t, merged.Last(), 8)
+	assertSingle(t, &a, "\n")
+	assertSingle(t, &b, "\n")
+}
+
+func TestSingleLinkedList_MergeFuncKeepsOwnValuesFirst(t *testing.T) {
+	// Arrange
+	list := EmptySingleLinkedList[pair]()
+	list.Add(pair{1, 0})
+	list.Add(pair{2, 0})
+	other := EmptySingleLinkedList[pair]()
+	other.Add(pair{1, 1})
+	other.Add(pair{2, 1})
+	// Act
+	list.MergeFunc(&other, comparePairs)
+	// Assert
+	util.SimpleAssert(t, list.Count, 4)
+	util.SimpleAssert(t, list.Get(0), pair{1, 0})
+	util.SimpleAssert(t, list.Get(1), pair{1, 1})
+	util.SimpleAssert(t, list.Last(), pair{2, 1})
+}
+
+func TestSingleLinkedList_MergeFuncSameList(t *testing.T) {
+	// Arrange
+	list := singleOf(1, 2)
+	defer func() {
+		// Assert
+		if r := recover(); r == nil {
+			t.Errorf("Expected a panic")
+		}
+	}()
+	// Act
+	list.MergeFunc(&list, cmp.Compare[int])
+}
+
+func TestDoubleLinkedList_Sort(t *testing.T) {
+	// Arrange
+	list := doubleOf(5, 3, 8, 1, 9, 2)
+	// Act
+	SortDouble(&list)
+	// Assert
+	assertDouble(t, &list, "1 2 3 5 8 9")
+	util.SimpleAssert(t, list.Tail.Value, 9)
+	util.SimpleAssert(t, IsSortedDouble(&list), true)
+}
+
+func TestDoubleLinkedList_SortFuncIsStable(t *testing.T) {
+	// Arrange
+	list := EmptyDoubleLinkedList[pair]()
+	for i, key := range []int{2, 1, 2, 1, 0, 2} {
+		list.Add(pair{key, i})
+	}
+	// Act
+	list.SortFunc(comparePairs)
+	// Assert
+	var got []pair
+	list.EachReverse(func(_ int, value pair) bool {
+		got = append(got, value)
+		return true
+	})
+	util.SimpleAssert(t, slices.Equal(got, []pair{{2, 5}, {2, 2}, {2, 0}, {1, 3}, {1, 1}, {0, 4}}), true)
+}
+
+func TestDoubleLinkedList_SortMatchesSlicesSort(t *testing.T) {
+	rng := rand.New(rand.NewSource(37))
+	for n := 0; n < 50; n++ {
+		// Arrange
+		values := make([]int, n)
+		for i := range values {
+			values[i] = rng.Intn(10)
+		}
+		list := doubleOf(values...)
+		// Act
+		SortDouble(&list)
+		slices.Sort(values)
+		expected := doubleOf(values...)
+		// Assert
+		assertDouble(t, &list, expected.ToString())
+	}
+}
+
+func TestDoubleLinkedList_InsertSorted(t *testing.T) {
+	// Arrange
+	list := EmptyDoubleLinkedList[int]()
+	// Act
+	for _, value := range []int{4, 1, 3, 5, 0} {
+		InsertSortedDouble(&list, value)
+	}
+	// Assert
+	assertDouble(t, &list, "0 1 3 4 5")
+	util.SimpleAssert(t, IsSortedDouble(&list), true)
+}
+
+func TestDoubleLinkedList_MergeSorted(t *testing.T) {
+	// Arrange
+	a := doubleOf(1, 4, 6)
+	b := doubleOf(2, 3, 7, 8)
+	// Act
+	merged := MergeSortedDouble(&a, &b)
+	// Assert
+	assertDouble(t, &merged, "1 2 3 4 6 7 8")
+	util.SimpleAssert(t, merged.Tail.Value, 8)
+	assertDouble(t, &a, "")
+	assertDouble(t, &b, "")
+}
@@ -7,7 +7,7 @@ import "github.com/robertjshirts/data-structures/graph"
 // Big-O: O(n^2) because it loops through each vertex in the graph and each connection of the vertex
 func Prim(inputGraph *graph.Graph) *graph.Graph {
 	// Validate graph
-	if inputGraph == nil || len(inputGraph.Vertices) == 0 {
+	if inputGraph == nil || inputGraph.Order() == 0 {
 		return graph.EmptyGraph()
 	}
 
@@ -16,10 +16,10 @@ func Prim(inputGraph *graph.Graph) *graph.Graph {
 
 	// Add the first vertex to the MST
 	firstVertex := inputGraph.GetVertex(inputGraph.GetKeys()[0])
-	mst.AddVertex(firstVertex.Key)
+	mst.AddVertex(firstVertex.Key())
 
 	// Loop until all vertices are in the MST
-	for len(mst.Vertices) < len(inputGraph.Vertices) {
+	for mst.Order() < inputGraph.Order() {
 		// Find the closest vertex to the MST that is not already in the MST
 		from, to, weight := findClosestConnection(inputGraph, mst)
 
@@ -41,16 +41,17 @@ func findClosestConnection(inputGraph *graph.Graph, mst *graph.Graph) (string, s
 	to := ""
 	currentWeight := 999999
 	// Look through each vertex in the MST
-	for key := range mst.Vertices {
-		// Look through each connection of the vertex
-		for connectionKey, weight := range inputGraph.GetVertex(key).Connections {
+	for _, key := range mst.GetKeys() {
+		// Look through each connection of the vertex, without copying them like Neighbors does
+		inputGraph.EachNeighbor(key, func(connectionKey string, weight int) bool {
 			// If the connection is not in the MST, and it is the closest one found so far, save it
-			if _, ok := mst.Vertices[connectionKey]; !ok && weight < currentWeight {
+			if !mst.HasVertex(connectionKey) && weight < currentWeight {
 				from = key
 				to = connectionKey
 				currentWeight = weight
 			}
-		}
+			return true
+		})
 	}
 	return from, to, currentWeight
 }
@@ -60,10 +61,11 @@ func findClosestConnection(inputGraph *graph.Graph, mst *graph.Graph) (string, s
 // Big-O: O(n^2) because it loops through each vertex in the graph and each connection of the vertex
 func Weight(g *graph.Graph) int {
 	weight := 0
-	for _, vertex := range g.Vertices {
-		for _, w := range vertex.Connections {
+	for _, key := range g.GetKeys() {
+		g.EachNeighbor(key, func(_ string, w int) bool {
 			weight += w
-		}
+			return true
+		})
 	}
 	// The weight is counted twice, so divide by 2
 	return weight / 2
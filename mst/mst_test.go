@@ -2,6 +2,8 @@ package mst
 
 import (
 	"github.com/robertjshirts/data-structures/graph"
+	"github.com/robertjshirts/data-structures/graph_gen"
+	"sort"
 	"strings"
 	"testing"
 )
@@ -53,8 +55,8 @@ func TestPrim_inputA_FindsAllVertices(t *testing.T) {
 	mst := Prim(inputGraph)
 
 	// Check the vertices
-	if len(mst.Vertices) != 5 {
-		t.Errorf("Expected 5 vertices, got %v", len(mst.Vertices))
+	if mst.Order() != 5 {
+		t.Errorf("Expected 5 vertices, got %v", mst.Order())
 	}
 }
 
@@ -66,19 +68,19 @@ func TestPrim_inputA_FindsCorrectVertices(t *testing.T) {
 	mst := Prim(inputGraph)
 
 	// Check the vertices
-	if mst.Vertices["AX1"] == nil {
+	if !mst.HasVertex("AX1") {
 		t.Errorf("Expected AX1 to be in the MST")
 	}
-	if mst.Vertices["AX2"] == nil {
+	if !mst.HasVertex("AX2") {
 		t.Errorf("Expected AX2 to be in the MST")
 	}
-	if mst.Vertices["AX3"] == nil {
+	if !mst.HasVertex("AX3") {
 		t.Errorf("Expected AX3 to be in the MST")
 	}
-	if mst.Vertices["AX4"] == nil {
+	if !mst.HasVertex("AX4") {
 		t.Errorf("Expected AX4 to be in the MST")
 	}
-	if mst.Vertices["AX5"] == nil {
+	if !mst.HasVertex("AX5") {
 		t.Errorf("Expected AX5 to be in the MST")
 	}
 }
@@ -91,20 +93,20 @@ func TestPrim_inputA_FindsAllConnections(t *testing.T) {
 	mst := Prim(inputGraph)
 
 	// Check the connections
-	if len(mst.Vertices["AX1"].Connections) != 2 {
-		t.Errorf("Expected 2 connection for AX1, got %v", len(mst.Vertices["AX1"].Connections))
+	if mst.Degree("AX1") != 2 {
+		t.Errorf("Expected 2 connection for AX1, got %v", mst.Degree("AX1"))
 	}
-	if len(mst.Vertices["AX2"].Connections) != 2 {
-		t.Errorf("Expected 2 connection for AX2, got %v", len(mst.Vertices["AX2"].Connections))
+	if mst.Degree("AX2") != 2 {
+		t.Errorf("Expected 2 connection for AX2, got %v", mst.Degree("AX2"))
 	}
-	if len(mst.Vertices["AX3"].Connections) != 1 {
-		t.Errorf("Expected 1 connection for AX3, got %v", len(mst.Vertices["AX3"].Connections))
+	if mst.Degree("AX3") != 1 {
+		t.Errorf("Expected 1 connection for AX3, got %v", mst.Degree("AX3"))
 	}
-	if len(mst.Vertices["AX4"].Connections) != 2 {
-		t.Errorf("Expected 2 connections for AX4, got %v", len(mst.Vertices["AX4"].Connections))
+	if mst.Degree("AX4") != 2 {
+		t.Errorf("Expected 2 connections for AX4, got %v", mst.Degree("AX4"))
 	}
-	if len(mst.Vertices["AX5"].Connections) != 1 {
-		t.Errorf("Expected 1 connection for AX5, got %v", len(mst.Vertices["AX5"].Connections))
+	if mst.Degree("AX5") != 1 {
+		t.Errorf("Expected 1 connection for AX5, got %v", mst.Degree("AX5"))
 	}
 }
 
@@ -129,8 +131,8 @@ func TestPrim_inputB_FindsAllVertices(t *testing.T) {
 	mst := Prim(inputGraph)
 
 	// Check the vertices
-	if len(mst.Vertices) != 5 {
-		t.Errorf("Expected 5 vertices, got %v", len(mst.Vertices))
+	if mst.Order() != 5 {
+		t.Errorf("Expected 5 vertices, got %v", mst.Order())
 	}
 }
 
@@ -142,19 +144,19 @@ func TestPrim_inputB_FindsCorrectVertices(t *testing.T) {
 	mst := Prim(inputGraph)
 
 	// Check the vertices
-	if mst.Vertices["AX10"] == nil {
+	if !mst.HasVertex("AX10") {
 		t.Errorf("Expected AX10 to be in the MST")
 	}
-	if mst.Vertices["AX11"] == nil {
+	if !mst.HasVertex("AX11") {
 		t.Errorf("Expected AX11 to be in the MST")
 	}
-	if mst.Vertices["AX12"] == nil {
+	if !mst.HasVertex("AX12") {
 		t.Errorf("Expected AX12 to be in the MST")
 	}
-	if mst.Vertices["AX99"] == nil {
+	if !mst.HasVertex("AX99") {
 		t.Errorf("Expected AX99 to be in the MST")
 	}
-	if mst.Vertices["AX100"] == nil {
+	if !mst.HasVertex("AX100") {
 		t.Errorf("Expected AX100 to be in the MST")
 	}
 }
@@ -167,19 +169,110 @@ func TestPrim_inputB_FindsAllConnections(t *testing.T) {
 	mst := Prim(inputGraph)
 
 	// Check the connections
-	if len(mst.Vertices["AX10"].Connections) != 1 {
-		t.Errorf("Expected 1 connection for AX10, got %v", len(mst.Vertices["AX10"].Connections))
+	if mst.Degree("AX10") != 1 {
+		t.Errorf("Expected 1 connection for AX10, got %v", mst.Degree("AX10"))
 	}
-	if len(mst.Vertices["AX11"].Connections) != 2 {
-		t.Errorf("Expected 1 connection for AX11, got %v", len(mst.Vertices["AX11"].Connections))
+	if mst.Degree("AX11") != 2 {
+		t.Errorf("Expected 1 connection for AX11, got %v", mst.Degree("AX11"))
 	}
-	if len(mst.Vertices["AX12"].Connections) != 2 {
-		t.Errorf("Expected 2 connections for AX12, got %v", len(mst.Vertices["AX12"].Connections))
+	if mst.Degree("AX12") != 2 {
+		t.Errorf("Expected 2 connections for AX12, got %v", mst.Degree("AX12"))
 	}
-	if len(mst.Vertices["AX99"].Connections) != 2 {
-		t.Errorf("Expected 1 connection for AX99, got %v", len(mst.Vertices["AX99"].Connections))
+	if mst.Degree("AX99") != 2 {
+		t.Errorf("Expected 1 connection for AX99, got %v", mst.Degree("AX99"))
 	}
-	if len(mst.Vertices["AX100"].Connections) != 1 {
-		t.Errorf("Expected 1 connection for AX100, got %v", len(mst.Vertices["AX100"].Connections))
+	if mst.Degree("AX100") != 1 {
+		t.Errorf("Expected 1 connection for AX100, got %v", mst.Degree("AX100"))
 	}
 }
+
+func TestPrim_inputA_IsValidTree(t *testing.T) {
+	// Create a new graph
+	inputGraph := graph.NewGraph(strings.Split(inputA, "\n"))
+
+	// Run Prim's algorithm
+	mst := Prim(inputGraph)
+
+	// Check that the MST is a valid graph with one less edge than vertices
+	if err := mst.Validate(); err != nil {
+		t.Errorf("Expected a valid graph, got %v", err)
+	}
+	if mst.Size() != mst.Order()-1 {
+		t.Errorf("Expected %v edges, got %v", mst.Order()-1, mst.Size())
+	}
+}
+
+func FuzzPrim_MatchesKruskal(f *testing.F) {
+	f.Add(int64(1), uint8(10), uint8(20))
+	f.Add(int64(2), uint8(1), uint8(0))
+	f.Add(int64(3), uint8(40), uint8(5))
+	f.Add(int64(4), uint8(60), uint8(100))
+	f.Fuzz(func(t *testing.T, seed int64, n uint8, percent uint8) {
+		// Create a random connected graph
+		gen := graph_gen.NewGenerator(seed, graph_gen.Uniform(1, 50))
+		inputGraph := gen.Connected(int(n), float64(percent%101)/100)
+
+		// Run Prim's algorithm
+		mst := Prim(inputGraph)
+
+		// Check that it's a spanning tree with the same weight as Kruskal's algorithm finds
+		if err := mst.Validate(); err != nil {
+			t.Fatalf("Expected a valid graph, got %v", err)
+		}
+		if mst.Order() != inputGraph.Order() {
+			t.Fatalf("Expected %v vertices, got %v", inputGraph.Order(), mst.Order())
+		}
+		if mst.Order() > 0 && mst.Size() != mst.Order()-1 {
+			t.Fatalf("Expected %v edges, got %v", mst.Order()-1, mst.Size())
+		}
+		if Weight(mst) != kruskalWeight(inputGraph) {
+			t.Fatalf("Expected total weight of %v, got %v", kruskalWeight(inputGraph), Weight(mst))
+		}
+	})
+}
+
+func BenchmarkPrim(b *testing.B) {
+	gen := graph_gen.NewGenerator(1, graph_gen.Uniform(1, 100))
+	inputGraph := gen.Connected(200, 0.05)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Prim(inputGraph)
+	}
+}
+
+// kruskalWeight finds the weight of the minimum spanning tree with Kruskal's algorithm, to check Prim against
+func kruskalWeight(g *graph.Graph) int {
+	type edge struct {
+		from, to string
+		weight   int
+	}
+	var edges []edge
+	parent := make(map[string]string)
+	for _, key := range g.GetKeys() {
+		parent[key] = key
+		for neighbor, weight := range g.Neighbors(key) {
+			if key < neighbor {
+				edges = append(edges, edge{key, neighbor, weight})
+			}
+		}
+	}
+	sort.Slice(edges, func(i, j int) bool { return edges[i].weight < edges[j].weight })
+
+	var find func(string) string
+	find = func(key string) string {
+		if parent[key] != key {
+			parent[key] = find(parent[key])
+		}
+		return parent[key]
+	}
+
+	weight := 0
+	for _, e := range edges {
+		a, b := find(e.from), find(e.to)
+		if a != b {
+			parent[a] = b
+			weight += e.weight
+		}
+	}
+	return weight
+}
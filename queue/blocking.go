@@ -0,0 +1,214 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrClosed is returned when adding to a closed BlockingQueue, or taking from one that's closed and empty
+var ErrClosed = errors.New("queue is closed")
+
+// BlockingQueue is a bounded first in, first out queue that's safe to share between goroutines.
+// Enqueue waits while the queue is full and Dequeue waits while it's empty, so producers and consumers can hand work to each other.
+//
+// Close stops new items from being added, but items already queued can still be dequeued,
+// so consumers can drain the queue before they see ErrClosed.
+type BlockingQueue[T any] struct {
+	mu       sync.Mutex
+	ring     ring[T]
+	capacity int
+	closed   bool
+	// notEmpty and notFull are closed to wake up waiting goroutines, then replaced for the next wait.
+	// The waiter counts let us skip that when nobody's waiting
+	notEmpty     chan struct{}
+	notFull      chan struct{}
+	emptyWaiters int
+	fullWaiters  int
+}
+
+// NewBlockingQueue creates an empty queue that holds at most capacity items
+//
+// # Panics if capacity is less than 1
+func NewBlockingQueue[T any](capacity int) *BlockingQueue[T] {
+	if capacity < 1 {
+		panic("Capacity must be at least 1")
+	}
+	return &BlockingQueue[T]{
+		capacity: capacity,
+		notEmpty: make(chan struct{}),
+		notFull:  make(chan struct{}),
+	}
+}
+
+// Enqueue adds a value to the end of the queue, waiting for space if it's full
+//
+// # Returns ErrClosed if the queue is closed, before or while waiting
+//
+// Time complexity: O(1) amortized, not counting the wait
+func (q *BlockingQueue[T]) Enqueue(value T) error {
+	return q.EnqueueContext(context.Background(), value)
+}
+
+// EnqueueContext adds a value to the end of the queue, waiting for space until ctx is done
+//
+// # Returns ErrClosed if the queue is closed, or ctx.Err() if ctx is done first
+//
+// Time complexity: O(1) amortized, not counting the wait
+func (q *BlockingQueue[T]) EnqueueContext(ctx context.Context, value T) error {
+	q.mu.Lock()
+	for !q.closed && q.ring.len() == q.capacity {
+		if err := q.wait(ctx, q.notFull, &q.fullWaiters); err != nil {
+			return err
+		}
+	}
+	if q.closed {
+		q.mu.Unlock()
+		return ErrClosed
+	}
+
+	q.ring.pushBack(value)
+	wake(&q.notEmpty, q.emptyWaiters)
+	q.mu.Unlock()
+	return nil
+}
+
+// TryEnqueue adds a value to the end of the queue if there's space, without waiting
+//
+// # Returns true if the value was added, false if the queue is full or closed
+//
+// Time complexity: O(1) amortized
+func (q *BlockingQueue[T]) TryEnqueue(value T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed || q.ring.len() == q.capacity {
+		return false
+	}
+
+	q.ring.pushBack(value)
+	wake(&q.notEmpty, q.emptyWaiters)
+	return true
+}
+
+// Dequeue removes the first value from the queue and returns it, waiting for one if the queue is empty
+//
+// # Returns ErrClosed once the queue is closed and empty
+//
+// Time complexity: O(1) amortized, not counting the wait
+func (q *BlockingQueue[T]) Dequeue() (T, error) {
+	return q.DequeueContext(context.Background())
+}
+
+// DequeueContext removes the first value from the queue and returns it, waiting for one until ctx is done
+//
+// # Returns ErrClosed once the queue is closed and empty, or ctx.Err() if ctx is done first
+//
+// Time complexity: O(1) amortized, not counting the wait
+func (q *BlockingQueue[T]) DequeueContext(ctx context.Context) (T, error) {
+	var zero T
+	q.mu.Lock()
+	for !q.closed && q.ring.len() == 0 {
+		if err := q.wait(ctx, q.notEmpty, &q.emptyWaiters); err != nil {
+			return zero, err
+		}
+	}
+	if q.ring.len() == 0 {
+		q.mu.Unlock()
+		return zero, ErrClosed
+	}
+
+	value := q.ring.popFront()
+	wake(&q.notFull, q.fullWaiters)
+	q.mu.Unlock()
+	return value, nil
+}
+
+// TryDequeue removes the first value from the queue if there is one, without waiting
+//
+// # Returns the value and true, or the zero value and false if the queue is empty
+//
+// Time complexity: O(1) amortized
+func (q *BlockingQueue[T]) TryDequeue() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.ring.len() == 0 {
+		var zero T
+		return zero, false
+	}
+
+	value := q.ring.popFront()
+	wake(&q.notFull, q.fullWaiters)
+	return value, true
+}
+
+// Close stops the queue from taking new values and wakes up every waiting goroutine.
+// Values already in the queue can still be dequeued. Closing more than once does nothing.
+//
+// Time complexity: O(1)
+func (q *BlockingQueue[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return
+	}
+
+	q.closed = true
+	wake(&q.notEmpty, q.emptyWaiters)
+	wake(&q.notFull, q.fullWaiters)
+}
+
+// Closed returns true once Close has been called
+//
+// Time complexity: O(1)
+func (q *BlockingQueue[T]) Closed() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.closed
+}
+
+// Len returns the number of values in the queue. Other goroutines can change it right after it's returned
+//
+// Time complexity: O(1)
+func (q *BlockingQueue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.ring.len()
+}
+
+// Cap returns the most values the queue can hold
+//
+// Time complexity: O(1)
+func (q *BlockingQueue[T]) Cap() int {
+	return q.capacity
+}
+
+// wait unlocks the queue until signal is closed or ctx is done, counting itself in waiters meanwhile.
+// It must be called with q.mu held. On success the lock is held again when it returns, on error it's left unlocked.
+func (q *BlockingQueue[T]) wait(ctx context.Context, signal chan struct{}, waiters *int) error {
+	*waiters++
+	q.mu.Unlock()
+
+	var err error
+	select {
+	case <-signal:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	q.mu.Lock()
+	*waiters--
+	if err != nil {
+		q.mu.Unlock()
+	}
+	return err
+}
+
+// wake closes signal to wake up everything waiting on it, and replaces it with a fresh channel for the next wait.
+// Does nothing if there are no waiters
+func wake(signal *chan struct{}, waiters int) {
+	if waiters == 0 {
+		return
+	}
+	close(*signal)
+	*signal = make(chan struct{})
+}
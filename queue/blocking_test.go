@@ -0,0 +1,204 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNewBlockingQueuePanicsOnZeroCapacity(t *testing.T) {
+	defer func() {
+		// Assert
+		if r := recover(); r == nil {
+			t.Errorf("Expected a panic")
+		}
+	}()
+	// Act
+	NewBlockingQueue[int](0)
+}
+
+func TestBlockingQueue_EnqueueDequeueInOrder(t *testing.T) {
+	// Arrange
+	queue := NewBlockingQueue[int](3)
+	// Act
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	first, err := queue.Dequeue()
+	// Assert
+	simpleAssert(t, err, nil)
+	simpleAssert(t, first, 1)
+	simpleAssert(t, queue.Len(), 1)
+	simpleAssert(t, queue.Cap(), 3)
+}
+
+func TestBlockingQueue_TryEnqueueWhenFull(t *testing.T) {
+	// Arrange
+	queue := NewBlockingQueue[int](1)
+	// Act
+	first := queue.TryEnqueue(1)
+	second := queue.TryEnqueue(2)
+	// Assert
+	simpleAssert(t, first, true)
+	simpleAssert(t, second, false)
+	simpleAssert(t, queue.Len(), 1)
+}
+
+func TestBlockingQueue_TryDequeueWhenEmpty(t *testing.T) {
+	// Arrange
+	queue := NewBlockingQueue[int](1)
+	// Act
+	_, ok := queue.TryDequeue()
+	// Assert
+	simpleAssert(t, ok, false)
+}
+
+func TestBlockingQueue_DequeueWaitsForEnqueue(t *testing.T) {
+	// Arrange
+	queue := NewBlockingQueue[int](1)
+	got := make(chan int)
+	go func() {
+		value, _ := queue.Dequeue()
+		got <- value
+	}()
+	// Act
+	time.Sleep(10 * time.Millisecond)
+	queue.Enqueue(5)
+	// Assert
+	simpleAssert(t, <-got, 5)
+}
+
+func TestBlockingQueue_EnqueueWaitsForSpace(t *testing.T) {
+	// Arrange
+	queue := NewBlockingQueue[int](1)
+	queue.Enqueue(1)
+	done := make(chan error)
+	go func() {
+		done <- queue.Enqueue(2)
+	}()
+	// Act
+	select {
+	case <-done:
+		t.Fatalf("Expected Enqueue to wait while the queue is full")
+	case <-time.After(10 * time.Millisecond):
+	}
+	first, _ := queue.Dequeue()
+	// Assert
+	simpleAssert(t, <-done, nil)
+	simpleAssert(t, first, 1)
+	second, _ := queue.Dequeue()
+	simpleAssert(t, second, 2)
+}
+
+func TestBlockingQueue_DequeueContextTimesOut(t *testing.T) {
+	// Arrange
+	queue := NewBlockingQueue[int](1)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	// Act
+	_, err := queue.DequeueContext(ctx)
+	// Assert
+	simpleAssert(t, errors.Is(err, context.DeadlineExceeded), true)
+	// The queue should still work after a waiter gives up
+	queue.Enqueue(1)
+	simpleAssert(t, queue.Len(), 1)
+}
+
+func TestBlockingQueue_EnqueueContextCancelled(t *testing.T) {
+	// Arrange
+	queue := NewBlockingQueue[int](1)
+	queue.Enqueue(1)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- queue.EnqueueContext(ctx, 2)
+	}()
+	// Act
+	cancel()
+	// Assert
+	simpleAssert(t, errors.Is(<-done, context.Canceled), true)
+	simpleAssert(t, queue.Len(), 1)
+}
+
+func TestBlockingQueue_CloseDrains(t *testing.T) {
+	// Arrange
+	queue := NewBlockingQueue[int](3)
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	// Act
+	queue.Close()
+	enqueueErr := queue.Enqueue(3)
+	first, firstErr := queue.Dequeue()
+	second, _ := queue.Dequeue()
+	_, emptyErr := queue.Dequeue()
+	// Assert
+	simpleAssert(t, queue.Closed(), true)
+	simpleAssert(t, errors.Is(enqueueErr, ErrClosed), true)
+	simpleAssert(t, queue.TryEnqueue(3), false)
+	simpleAssert(t, firstErr, nil)
+	simpleAssert(t, first, 1)
+	simpleAssert(t, second, 2)
+	simpleAssert(t, errors.Is(emptyErr, ErrClosed), true)
+}
+
+func TestBlockingQueue_CloseWakesWaiters(t *testing.T) {
+	// Arrange
+	queue := NewBlockingQueue[int](1)
+	done := make(chan error)
+	for i := 0; i < 3; i++ {
+		go func() {
+			_, err := queue.Dequeue()
+			done <- err
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	// Act
+	queue.Close()
+	queue.Close()
+	// Assert
+	for i := 0; i < 3; i++ {
+		simpleAssert(t, errors.Is(<-done, ErrClosed), true)
+	}
+}
+
+func TestBlockingQueue_ProducersAndConsumers(t *testing.T) {
+	// Arrange
+	queue := NewBlockingQueue[int](4)
+	const producers, perProducer = 4, 500
+	var producing, consuming sync.WaitGroup
+	var mu sync.Mutex
+	sum, count := 0, 0
+	// Act
+	for p := 0; p < producers; p++ {
+		producing.Add(1)
+		go func() {
+			defer producing.Done()
+			for i := 1; i <= perProducer; i++ {
+				queue.Enqueue(i)
+			}
+		}()
+	}
+	for c := 0; c < 3; c++ {
+		consuming.Add(1)
+		go func() {
+			defer consuming.Done()
+			for {
+				value, err := queue.Dequeue()
+				if err != nil {
+					return
+				}
+				mu.Lock()
+				sum += value
+				count++
+				mu.Unlock()
+			}
+		}()
+	}
+	producing.Wait()
+	queue.Close()
+	consuming.Wait()
+	// Assert
+	simpleAssert(t, count, producers*perProducer)
+	simpleAssert(t, sum, producers*perProducer*(perProducer+1)/2)
+}
@@ -0,0 +1,28 @@
+package queue
+
+// Dequeue removes the first value from the queue and returns it as a pointer
+//
+// # Returns the first value of the queue, as a pointer. Returns nil if the queue is empty
+//
+// Deprecated: Use TryDequeue, which returns (value, ok) and doesn't make the value escape to the heap.
+func (q *Queue[T]) Dequeue() *T {
+	value, ok := q.TryDequeue()
+	if !ok {
+		return nil
+	}
+	return &value
+}
+
+// Peek returns the first value of the queue, as a pointer.
+// The pointer is to a copy, so changing the value through it doesn't change the queue.
+//
+// # Returns the first value of the queue, as a pointer. Returns nil if the queue is empty
+//
+// Deprecated: Use TryPeek, which returns (value, ok).
+func (q *Queue[T]) Peek() *T {
+	value, ok := q.TryPeek()
+	if !ok {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,20 @@
+package queue
+
+import "testing"
+
+func TestQueue_DeprecatedPointerForms(t *testing.T) {
+	// Arrange
+	queue := NewQueue[int]()
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	// Act
+	peeked := queue.Peek()
+	*peeked = 100
+	dequeued := queue.Dequeue()
+	// Assert
+	// Peek hands out a copy, so writing through it doesn't change the queue
+	simpleAssert(t, *dequeued, 1)
+	queue.Dequeue()
+	nilAssert(t, queue.Dequeue())
+	nilAssert(t, queue.Peek())
+}
@@ -0,0 +1,124 @@
+package queue
+
+// Deque is a double-ended queue backed by a growable ring buffer, so both ends are O(1) and so is Get.
+// It's handy for sliding windows, where old items leave the front while new ones arrive at the back,
+// and for work-stealing, where a worker takes from one end and thieves take from the other.
+//
+// A Deque is not safe for concurrent use.
+type Deque[T any] struct {
+	ring ring[T]
+}
+
+func NewDeque[T any]() *Deque[T] {
+	return &Deque[T]{}
+}
+
+// PushFront adds a value to the front of the deque
+//
+// Time complexity: O(1) amortized
+// Because the ring buffer only has to grow when it's full, and it doubles when it does
+func (d *Deque[T]) PushFront(value T) {
+	d.ring.pushFront(value)
+}
+
+// PushBack adds a value to the back of the deque
+//
+// Time complexity: O(1) amortized
+// Because the ring buffer only has to grow when it's full, and it doubles when it does
+func (d *Deque[T]) PushBack(value T) {
+	d.ring.pushBack(value)
+}
+
+// PopFront removes the value at the front of the deque and returns it
+//
+// # Returns the value and true, or the zero value and false if the deque is empty
+//
+// Time complexity: O(1) amortized, because we're just moving the front of the ring buffer forward
+func (d *Deque[T]) PopFront() (T, bool) {
+	if d.ring.len() == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.ring.popFront(), true
+}
+
+// PopBack removes the value at the back of the deque and returns it
+//
+// # Returns the value and true, or the zero value and false if the deque is empty
+//
+// Time complexity: O(1) amortized, because we're just moving the back of the ring buffer backward
+func (d *Deque[T]) PopBack() (T, bool) {
+	if d.ring.len() == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.ring.popBack(), true
+}
+
+// PeekFront returns the value at the front of the deque without removing it
+//
+// # Returns the value and true, or the zero value and false if the deque is empty
+//
+// Time complexity: O(1), because the ring buffer can index straight to it
+func (d *Deque[T]) PeekFront() (T, bool) {
+	return d.Get(0)
+}
+
+// PeekBack returns the value at the back of the deque without removing it
+//
+// # Returns the value and true, or the zero value and false if the deque is empty
+//
+// Time complexity: O(1), because the ring buffer can index straight to it
+func (d *Deque[T]) PeekBack() (T, bool) {
+	return d.Get(d.ring.len() - 1)
+}
+
+// Get returns the value at the given index, counting from the front
+//
+// # Returns the value and true, or the zero value and false if the index is out of bounds
+//
+// Time complexity: O(1), because the ring buffer can index straight to it
+func (d *Deque[T]) Get(index int) (T, bool) {
+	if index < 0 || index >= d.ring.len() {
+		var zero T
+		return zero, false
+	}
+	return *d.ring.at(index), true
+}
+
+// Set replaces the value at the given index, counting from the front
+//
+// # Returns false if the index is out of bounds, true otherwise
+//
+// Time complexity: O(1), because the ring buffer can index straight to it
+func (d *Deque[T]) Set(index int, value T) bool {
+	if index < 0 || index >= d.ring.len() {
+		return false
+	}
+	*d.ring.at(index) = value
+	return true
+}
+
+// Len returns the number of values in the deque
+//
+// Time complexity: O(1), because the ring buffer keeps a count
+func (d *Deque[T]) Len() int {
+	return d.ring.len()
+}
+
+// Clear removes every value from the deque
+//
+// Time complexity: O(1), because the garbage collector frees the old buffer
+func (d *Deque[T]) Clear() {
+	d.ring.clear()
+}
+
+// Each calls fn with the index and value of each item, from the front of the deque to the back, using the same indexes as Get.
+// Stops early if fn returns false.
+//
+// This makes the deque a linked_list.Iterable, so linked_list.Reduce, FindFunc, IndexFunc and ContainsFunc work on it.
+//
+// Time complexity: O(n), because it visits every value once
+func (d *Deque[T]) Each(fn func(index int, value T) bool) {
+	d.ring.each(fn)
+}
@@ -0,0 +1,130 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/robertjshirts/data-structures/linked_list"
+)
+
+func TestDeque_PushAndPopBothEnds(t *testing.T) {
+	// Arrange
+	deque := NewDeque[int]()
+	// Act
+	deque.PushBack(2)
+	deque.PushFront(1)
+	deque.PushBack(3)
+	// Assert
+	simpleAssert(t, deque.Len(), 3)
+	front, _ := deque.PopFront()
+	back, _ := deque.PopBack()
+	simpleAssert(t, front, 1)
+	simpleAssert(t, back, 3)
+	simpleAssert(t, deque.Len(), 1)
+}
+
+func TestDeque_PopOnEmptyDeque(t *testing.T) {
+	// Arrange
+	deque := NewDeque[int]()
+	// Act
+	_, frontOk := deque.PopFront()
+	_, backOk := deque.PopBack()
+	// Assert
+	simpleAssert(t, frontOk, false)
+	simpleAssert(t, backOk, false)
+}
+
+func TestDeque_Peek(t *testing.T) {
+	// Arrange
+	deque := NewDeque[string]()
+	_, emptyOk := deque.PeekFront()
+	deque.PushBack("a")
+	deque.PushBack("b")
+	// Act
+	front, frontOk := deque.PeekFront()
+	back, backOk := deque.PeekBack()
+	// Assert
+	simpleAssert(t, emptyOk, false)
+	simpleAssert(t, front, "a")
+	simpleAssert(t, frontOk, true)
+	simpleAssert(t, back, "b")
+	simpleAssert(t, backOk, true)
+	simpleAssert(t, deque.Len(), 2)
+}
+
+func TestDeque_GetAndSet(t *testing.T) {
+	// Arrange
+	deque := NewDeque[int]()
+	for i := 0; i < 20; i++ {
+		deque.PushFront(i)
+	}
+	// Act
+	ok := deque.Set(5, 100)
+	outOfBounds := deque.Set(20, 100)
+	// Assert
+	got, _ := deque.Get(5)
+	simpleAssert(t, got, 100)
+	simpleAssert(t, ok, true)
+	simpleAssert(t, outOfBounds, false)
+	first, _ := deque.Get(0)
+	simpleAssert(t, first, 19)
+	_, missing := deque.Get(-1)
+	simpleAssert(t, missing, false)
+}
+
+func TestDeque_SlidingWindowMax(t *testing.T) {
+	// Arrange
+	values := []int{1, 3, -1, -3, 5, 3, 6, 7}
+	window := 3
+	want := []int{3, 3, 5, 5, 6, 7}
+	// Indexes of values, with their values decreasing from front to back
+	deque := NewDeque[int]()
+	var got []int
+	// Act
+	for i, value := range values {
+		for {
+			back, ok := deque.PeekBack()
+			if !ok || values[back] > value {
+				break
+			}
+			deque.PopBack()
+		}
+		deque.PushBack(i)
+		if front, _ := deque.PeekFront(); front <= i-window {
+			deque.PopFront()
+		}
+		if i >= window-1 {
+			front, _ := deque.PeekFront()
+			got = append(got, values[front])
+		}
+	}
+	// Assert
+	simpleAssert(t, len(got), len(want))
+	for i := range want {
+		simpleAssert(t, got[i], want[i])
+	}
+}
+
+func TestDeque_IsIterable(t *testing.T) {
+	// Arrange
+	deque := NewDeque[int]()
+	deque.PushBack(1)
+	deque.PushBack(2)
+	deque.PushFront(3)
+	// Act
+	sum := linked_list.Reduce[int](deque, 0, func(total, value int) int { return total + value })
+	// Assert
+	simpleAssert(t, sum, 6)
+}
+
+func TestDeque_Clear(t *testing.T) {
+	// Arrange
+	deque := NewDeque[int]()
+	deque.PushBack(1)
+	// Act
+	deque.Clear()
+	deque.PushBack(2)
+	// Assert
+	got, _ := deque.PeekFront()
+	simpleAssert(t, deque.Len(), 1)
+	simpleAssert(t, got, 2)
+}
@@ -0,0 +1,46 @@
+package queue
+
+// Each calls fn with the index and value of each item, from the front of the queue to the back, using the same indexes as Get.
+// Stops early if fn returns false.
+//
+// This makes the queue a linked_list.Iterable, so linked_list.Reduce, FindFunc, IndexFunc and ContainsFunc work on it.
+//
+// Time complexity: O(n), because it visits every value once
+func (q *Queue[T]) Each(fn func(index int, value T) bool) {
+	q.ring.each(fn)
+}
+
+// Map creates a new queue with fn applied to every value, keeping the same order
+//
+// Time complexity: O(n), because it visits every value once
+func Map[T, U any](q *Queue[T], fn func(T) U) *Queue[U] {
+	result := NewQueue[U]()
+	q.Each(func(_ int, value T) bool {
+		result.Enqueue(fn(value))
+		return true
+	})
+	return result
+}
+
+// Filter creates a new queue with only the values the predicate returns true for, keeping the same order
+//
+// Time complexity: O(n), because it visits every value once
+func Filter[T any](q *Queue[T], predicate func(T) bool) *Queue[T] {
+	result := NewQueue[T]()
+	q.Each(func(_ int, value T) bool {
+		if predicate(value) {
+			result.Enqueue(value)
+		}
+		return true
+	})
+	return result
+}
+
+// RemoveIf removes every value the predicate returns true for, in place
+//
+// # Returns the number of values removed
+//
+// Time complexity: O(n), because every kept value moves at most once
+func RemoveIf[T any](q *Queue[T], predicate func(T) bool) int {
+	return q.ring.removeIf(predicate)
+}
@@ -0,0 +1,78 @@
+package queue
+
+import (
+	"github.com/robertjshirts/data-structures/linked_list"
+	"strconv"
+	"testing"
+)
+
+func TestQueue_EachVisitsFromFront(t *testing.T) {
+	// Arrange
+	queue := NewQueue[int]()
+	queue.Enqueue(0)
+	queue.Enqueue(1)
+	var values []int
+	// Act
+	queue.Each(func(index int, value int) bool {
+		values = append(values, value)
+		return true
+	})
+	// Assert
+	simpleAssert(t, len(values), 2)
+	simpleAssert(t, values[0], 0)
+}
+
+func TestQueue_WorksWithIterableHelpers(t *testing.T) {
+	// Arrange
+	queue := NewQueue[string]()
+	queue.Enqueue("a")
+	queue.Enqueue("bb")
+	// Act
+	value, ok := linked_list.FindFunc[string](queue, func(value string) bool { return len(value) == 2 })
+	contains := linked_list.ContainsFunc[string](queue, func(value string) bool { return value == "c" })
+	// Assert
+	simpleAssert(t, value, "bb")
+	simpleAssert(t, ok, true)
+	simpleAssert(t, contains, false)
+}
+
+func TestMap_KeepsOrder(t *testing.T) {
+	// Arrange
+	queue := NewQueue[int]()
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	// Act
+	got := Map(queue, strconv.Itoa)
+	// Assert
+	simpleAssert(t, *got.Dequeue(), "1")
+	simpleAssert(t, *got.Dequeue(), "2")
+	nilAssert(t, got.Dequeue())
+}
+
+func TestFilter_KeepsMatches(t *testing.T) {
+	// Arrange
+	queue := NewQueue[int]()
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	queue.Enqueue(4)
+	// Act
+	got := Filter(queue, func(value int) bool { return value%2 == 0 })
+	// Assert
+	simpleAssert(t, *got.Dequeue(), 2)
+	simpleAssert(t, *got.Dequeue(), 4)
+	nilAssert(t, got.Dequeue())
+}
+
+func TestRemoveIf_RemovesMatches(t *testing.T) {
+	// Arrange
+	queue := NewQueue[int]()
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	queue.Enqueue(3)
+	// Act
+	removed := RemoveIf(queue, func(value int) bool { return value != 2 })
+	// Assert
+	simpleAssert(t, removed, 2)
+	simpleAssert(t, *queue.Dequeue(), 2)
+	nilAssert(t, queue.Dequeue())
+}
@@ -0,0 +1,113 @@
+package queue
+
+import "sync/atomic"
+
+type lockFreeNode[T any] struct {
+	value T
+	next  atomic.Pointer[lockFreeNode[T]]
+}
+
+// LockFreeQueue is a Michael-Scott queue: a linked list with a dummy node at the front,
+// where the head, the tail and each next pointer are swapped with compare-and-swap,
+// so any number of goroutines can enqueue and dequeue at once without locks.
+// It has the same Enqueue, TryDequeue and TryPeek as Queue.
+//
+// The dummy node is the last node dequeued, so it keeps its value alive until the next TryDequeue.
+type LockFreeQueue[T any] struct {
+	head  atomic.Pointer[lockFreeNode[T]]
+	tail  atomic.Pointer[lockFreeNode[T]]
+	count atomic.Int64
+}
+
+func NewLockFreeQueue[T any]() *LockFreeQueue[T] {
+	q := &LockFreeQueue[T]{}
+	dummy := &lockFreeNode[T]{}
+	q.head.Store(dummy)
+	q.tail.Store(dummy)
+	return q
+}
+
+// Enqueue adds a value to the end of the queue
+//
+// Time complexity: O(1), plus a retry each time another goroutine changes the tail first
+func (q *LockFreeQueue[T]) Enqueue(value T) {
+	newNode := &lockFreeNode[T]{value: value}
+	for {
+		tail := q.tail.Load()
+		next := tail.next.Load()
+		if tail != q.tail.Load() {
+			continue
+		}
+		if next != nil {
+			// Another enqueue linked its node but hasn't moved the tail yet, so help it along
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		if tail.next.CompareAndSwap(nil, newNode) {
+			// If this fails, someone else already moved the tail for us
+			q.tail.CompareAndSwap(tail, newNode)
+			q.count.Add(1)
+			return
+		}
+	}
+}
+
+// TryDequeue removes the first value from the queue and returns it
+//
+// # Returns the first value of the queue and true, or the zero value and false if the queue is empty
+//
+// Time complexity: O(1), plus a retry each time another goroutine changes the head first
+func (q *LockFreeQueue[T]) TryDequeue() (T, bool) {
+	for {
+		head := q.head.Load()
+		tail := q.tail.Load()
+		next := head.next.Load()
+		if head != q.head.Load() {
+			continue
+		}
+		if next == nil {
+			var zero T
+			return zero, false
+		}
+		if head == tail {
+			// The tail is behind an enqueue that's still finishing, so help it along
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		// Read the value before the swap, since next becomes the new dummy once it succeeds
+		value := next.value
+		if q.head.CompareAndSwap(head, next) {
+			q.count.Add(-1)
+			return value, true
+		}
+	}
+}
+
+// TryPeek returns the first value of the queue, without removing it. Another goroutine may dequeue it right after
+//
+// # Returns the first value of the queue and true, or the zero value and false if the queue is empty
+//
+// Time complexity: O(1)
+func (q *LockFreeQueue[T]) TryPeek() (T, bool) {
+	first := q.head.Load().next.Load()
+	if first == nil {
+		var zero T
+		return zero, false
+	}
+	return first.value, true
+}
+
+// Len returns the number of values in the queue. The count is updated just after each enqueue or dequeue,
+// so it can be briefly off while other goroutines are busy, but it never goes below 0
+//
+// Time complexity: O(1)
+func (q *LockFreeQueue[T]) Len() int {
+	return max(0, int(q.count.Load()))
+}
+
+// IsEmpty returns true if there are no values in the queue
+//
+// Time complexity: O(1)
+func (q *LockFreeQueue[T]) IsEmpty() bool {
+	return q.head.Load().next.Load() == nil
+}
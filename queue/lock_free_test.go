@@ -0,0 +1,145 @@
+package queue
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestLockFreeQueue_EnqueueDequeue(t *testing.T) {
+	// Arrange
+	queue := NewLockFreeQueue[int]()
+	// Act
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	// Assert
+	front, ok := queue.TryPeek()
+	okAssert(t, front, ok, 1)
+	simpleAssert(t, queue.Len(), 2)
+	got, ok := queue.TryDequeue()
+	okAssert(t, got, ok, 1)
+	got, ok = queue.TryDequeue()
+	okAssert(t, got, ok, 2)
+	_, ok = queue.TryDequeue()
+	simpleAssert(t, ok, false)
+	_, ok = queue.TryPeek()
+	simpleAssert(t, ok, false)
+	simpleAssert(t, queue.IsEmpty(), true)
+}
+
+func TestLockFreeQueue_ConcurrentProducersAndConsumers(t *testing.T) {
+	// Arrange
+	queue := NewLockFreeQueue[int]()
+	const producers, perProducer = 8, 2000
+	var producing, consuming sync.WaitGroup
+	received := make(chan int, producers*perProducer)
+	done := make(chan struct{})
+	// Act
+	for p := 0; p < producers; p++ {
+		producing.Add(1)
+		go func(p int) {
+			defer producing.Done()
+			for i := 0; i < perProducer; i++ {
+				// Encode the producer in the value so each producer's order can be checked
+				queue.Enqueue(p*perProducer + i)
+			}
+		}(p)
+	}
+	for c := 0; c < 8; c++ {
+		consuming.Add(1)
+		go func() {
+			defer consuming.Done()
+			for {
+				value, ok := queue.TryDequeue()
+				if ok {
+					received <- value
+					continue
+				}
+				select {
+				case <-done:
+					return
+				default:
+				}
+			}
+		}()
+	}
+	producing.Wait()
+	close(done)
+	consuming.Wait()
+	close(received)
+	// Assert
+	seen := make([]bool, producers*perProducer)
+	count := 0
+	for value := range received {
+		simpleAssert(t, seen[value], false)
+		seen[value] = true
+		count++
+	}
+	simpleAssert(t, count, producers*perProducer)
+	simpleAssert(t, queue.Len(), 0)
+}
+
+func TestLockFreeQueue_KeepsOrderPerProducer(t *testing.T) {
+	// Arrange
+	queue := NewLockFreeQueue[int]()
+	const producers, perProducer = 4, 1000
+	var wg sync.WaitGroup
+	// Act
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				queue.Enqueue(p*perProducer + i)
+			}
+		}(p)
+	}
+	wg.Wait()
+	// Assert
+	last := make([]int, producers)
+	for p := range last {
+		last[p] = -1
+	}
+	for value, ok := queue.TryDequeue(); ok; value, ok = queue.TryDequeue() {
+		p, i := value/perProducer, value%perProducer
+		simpleAssert(t, i > last[p], true)
+		last[p] = i
+	}
+}
+
+// mutexQueue is the lock-based queue the lock-free one is benchmarked against
+type mutexQueue[T any] struct {
+	mu    sync.Mutex
+	queue Queue[T]
+}
+
+func (q *mutexQueue[T]) Enqueue(value T) {
+	q.mu.Lock()
+	q.queue.Enqueue(value)
+	q.mu.Unlock()
+}
+
+func (q *mutexQueue[T]) TryDequeue() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.queue.TryDequeue()
+}
+
+func BenchmarkLockFreeQueue_Parallel(b *testing.B) {
+	queue := NewLockFreeQueue[int]()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			queue.Enqueue(i)
+			queue.TryDequeue()
+		}
+	})
+}
+
+func BenchmarkMutexQueue_Parallel(b *testing.B) {
+	queue := &mutexQueue[int]{}
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			queue.Enqueue(i)
+			queue.TryDequeue()
+		}
+	})
+}
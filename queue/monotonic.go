@@ -0,0 +1,86 @@
+package queue
+
+// MonotonicQueue is a first in, first out queue that can also tell you its extreme value in O(1),
+// which makes it a sliding window minimum or maximum. With cmp.Compare the extreme is the minimum,
+// and with the arguments swapped it's the maximum.
+//
+// It keeps every value in a Queue, so TryDequeue knows which one is oldest,
+// plus a Deque of candidates for the extreme, ordered from best at the front to worst at the back.
+// A value stops being a candidate once a better one arrives after it, since it will leave the window first.
+type MonotonicQueue[T any] struct {
+	values     Queue[T]
+	candidates Deque[T]
+	cmp        func(a, b T) int
+}
+
+// NewMonotonicQueue creates an empty MonotonicQueue. cmp returns a negative number if a is more extreme than b,
+// a positive number if b is more extreme, and 0 if they're tied
+func NewMonotonicQueue[T any](cmp func(a, b T) int) *MonotonicQueue[T] {
+	return &MonotonicQueue[T]{cmp: cmp}
+}
+
+// Enqueue adds a value to the end of the queue
+//
+// Time complexity: O(1) amortized
+// Because each value is added to and removed from the candidates at most once
+func (q *MonotonicQueue[T]) Enqueue(value T) {
+	q.values.Enqueue(value)
+	// Keep ties, so dequeuing one copy of the extreme leaves the other
+	for {
+		back, ok := q.candidates.PeekBack()
+		if !ok || q.cmp(back, value) <= 0 {
+			break
+		}
+		q.candidates.PopBack()
+	}
+	q.candidates.PushBack(value)
+}
+
+// TryDequeue removes the first value from the queue and returns it
+//
+// # Returns the first value of the queue and true, or the zero value and false if the queue is empty
+//
+// Time complexity: O(1) amortized
+func (q *MonotonicQueue[T]) TryDequeue() (T, bool) {
+	value, ok := q.values.TryDequeue()
+	if !ok {
+		return value, false
+	}
+	// The oldest value is only still a candidate if it's the current extreme
+	if front, _ := q.candidates.PeekFront(); q.cmp(value, front) == 0 {
+		q.candidates.PopFront()
+	}
+	return value, true
+}
+
+// TryPeek returns the first value of the queue, without removing it
+//
+// # Returns the first value of the queue and true, or the zero value and false if the queue is empty
+//
+// Time complexity: O(1)
+func (q *MonotonicQueue[T]) TryPeek() (T, bool) {
+	return q.values.TryPeek()
+}
+
+// Extreme returns the most extreme value in the queue according to cmp, so the minimum for cmp.Compare
+//
+// # Returns the value and true, or the zero value and false if the queue is empty
+//
+// Time complexity: O(1), because it's the front of the candidates
+func (q *MonotonicQueue[T]) Extreme() (T, bool) {
+	return q.candidates.PeekFront()
+}
+
+// Len returns the number of values in the queue
+//
+// Time complexity: O(1)
+func (q *MonotonicQueue[T]) Len() int {
+	return q.values.Len()
+}
+
+// IsEmpty returns true if there are no values in the queue
+//
+// Time complexity: O(1)
+func (q *MonotonicQueue[T]) IsEmpty() bool {
+	return q.values.IsEmpty()
+}
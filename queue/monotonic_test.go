@@ -0,0 +1,83 @@
+package queue
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestMonotonicQueue_SlidingWindowMin(t *testing.T) {
+	// Arrange
+	values := []int{4, 2, 12, 3, 8, 2, 5, 9}
+	want := []int{2, 2, 3, 2, 2, 2}
+	queue := NewMonotonicQueue(cmp.Compare[int])
+	var got []int
+	// Act
+	for i, value := range values {
+		queue.Enqueue(value)
+		if queue.Len() > 3 {
+			queue.TryDequeue()
+		}
+		if i >= 2 {
+			smallest, _ := queue.Extreme()
+			got = append(got, smallest)
+		}
+	}
+	// Assert
+	simpleAssert(t, slices.Equal(got, want), true)
+}
+
+func TestMonotonicQueue_SlidingWindowMax(t *testing.T) {
+	// Arrange
+	queue := NewMonotonicQueue(func(a, b int) int { return cmp.Compare(b, a) })
+	// Act
+	queue.Enqueue(1)
+	queue.Enqueue(5)
+	queue.Enqueue(5)
+	queue.Enqueue(2)
+	queue.TryDequeue()
+	queue.TryDequeue()
+	// Assert
+	largest, ok := queue.Extreme()
+	okAssert(t, largest, ok, 5)
+	front, _ := queue.TryPeek()
+	simpleAssert(t, front, 5)
+	queue.TryDequeue()
+	largest, _ = queue.Extreme()
+	simpleAssert(t, largest, 2)
+}
+
+func TestMonotonicQueue_Empty(t *testing.T) {
+	// Arrange
+	queue := NewMonotonicQueue(cmp.Compare[int])
+	// Act
+	_, dequeueOk := queue.TryDequeue()
+	_, extremeOk := queue.Extreme()
+	// Assert
+	simpleAssert(t, dequeueOk, false)
+	simpleAssert(t, extremeOk, false)
+	simpleAssert(t, queue.IsEmpty(), true)
+}
+
+func TestMonotonicQueue_MatchesSlicesMin(t *testing.T) {
+	// Arrange
+	rng := rand.New(rand.NewSource(47))
+	queue := NewMonotonicQueue(cmp.Compare[int])
+	var values []int
+	// Act & Assert
+	for i := 0; i < 1000; i++ {
+		if len(values) > 0 && rng.Intn(3) == 0 {
+			queue.TryDequeue()
+			values = values[1:]
+		} else {
+			value := rng.Intn(20)
+			queue.Enqueue(value)
+			values = append(values, value)
+		}
+		if len(values) > 0 {
+			got, _ := queue.Extreme()
+			simpleAssert(t, got, slices.Min(values))
+		}
+	}
+}
@@ -1,16 +1,15 @@
 package queue
 
-import "github.com/robertjshirts/data-structures/linked_list"
+import "fmt"
 
-type Queue[T comparable] struct {
-	list *linked_list.DoubleLinkedList[T]
+// Queue is a first in, first out queue backed by a growable ring buffer,
+// so it doesn't allocate per item and Get is O(1)
+type Queue[T any] struct {
+	ring ring[T]
 }
 
-func NewQueue[T comparable]() *Queue[T] {
-	list := linked_list.EmptyDoubleLinkedList[T]()
-	return &Queue[T]{
-		list: &list,
-	}
+func NewQueue[T any]() *Queue[T] {
+	return &Queue[T]{}
 }
 
 // Enqueue adds a value to the end of the queue
@@ -18,68 +17,143 @@ func NewQueue[T comparable]() *Queue[T] {
 // # Arguments
 // value: The value to add to the queue
 //
-// Time complexity: O(1)
-// Because list.Push is O(1), the time complexity is O(1)
+// Time complexity: O(1) amortized
+// Because the ring buffer only has to grow when it's full, and it doubles when it does
 func (q *Queue[T]) Enqueue(value T) {
-	q.list.Add(value)
+	q.ring.pushBack(value)
 }
 
-// Dequeue removes the first value from the queue and returns it as a pointer
+// TryDequeue removes the first value from the queue and returns it
 //
-// # Returns the first value of the queue, as a pointer. Returns nil if the queue is empty
+// # Returns the first value of the queue and true, or the zero value and false if the queue is empty
 //
-// Time complexity: O(1)
-// Because we're just removing the first value in the list, the time complexity is O(1)
+// Time complexity: O(1) amortized
+// Because we're just moving the front of the ring buffer forward, the time complexity is O(1)
 //
 // Pseudo code:
 // if the queue is empty
 //
-//	return nil
+//	return zero, false
 //
-// value = list.Remove()
-// return value
-func (q *Queue[T]) Dequeue() *T {
-	if q.list.Head == nil {
-		return nil
+// value = ring.popFront()
+// return value, true
+func (q *Queue[T]) TryDequeue() (T, bool) {
+	if q.ring.len() == 0 {
+		var zero T
+		return zero, false
 	}
 
-	value := q.list.Remove()
-	return &value
+	return q.ring.popFront(), true
 }
 
-// Peek returns the first value of the queue, as a pointer
+// TryPeek returns a copy of the first value of the queue, without removing it
 //
-// # Returns the first value of the queue, as a pointer. Returns nil if the queue is empty
+// # Returns the first value of the queue and true, or the zero value and false if the queue is empty
 //
 // Time complexity: O(1)
-// Because we're just getting the first value in the list, the time complexity is O(1)
-func (q *Queue[T]) Peek() *T {
-	if q.list.Head == nil {
-		return nil
+// Because we're just getting the first value in the ring buffer, the time complexity is O(1)
+func (q *Queue[T]) TryPeek() (T, bool) {
+	if q.ring.len() == 0 {
+		var zero T
+		return zero, false
 	}
 
-	return &q.list.Head.Value
+	return *q.ring.at(0), true
 }
 
 // Get returns the value at the given index
 //
 // # Returns the value at the given index, as a pointer. Returns nil if the index is out of bounds
 //
-// Time complexity: O(n), because q.list.Get is O(n)
+// Time complexity: O(1), because the ring buffer can index straight to it
 func (q *Queue[T]) Get(index int) *T {
-	if index < 0 || index >= q.list.Count {
+	if index < 0 || index >= q.ring.len() {
 		return nil
 	}
 
-	value := q.list.Get(index)
+	value := *q.ring.at(index)
 	return &value
 }
 
-// Contains returns true if the queue contains the given value, false otherwise
+// Contains returns true if the queue contains the given value, false otherwise.
+// It's a function instead of a method because only comparable values can be checked with ==
 //
 // # Returns true if the value is in the queue, false otherwise
 //
-// Time complexity: O(n), because q.list.Search is O(n)
-func (q *Queue[T]) Contains(value T) bool {
-	return q.list.Search(value) != -1
+// Time complexity: O(n), because q.ContainsFunc is O(n)
+func Contains[T comparable](q *Queue[T], value T) bool {
+	return q.ContainsFunc(func(other T) bool { return other == value })
+}
+
+// ContainsFunc returns true if match returns true for any value in the queue, false otherwise
+//
+// # Returns true if a value matched, false otherwise
+//
+// Time complexity: O(n), because it may check every value in the ring buffer
+func (q *Queue[T]) ContainsFunc(match func(T) bool) bool {
+	for i := 0; i < q.ring.len(); i++ {
+		if match(*q.ring.at(i)) {
+			return true
+		}
+	}
+	return false
+}
+
+// DequeueN removes up to n values from the front of the queue and returns them, front first.
+// Returns fewer than n values if the queue runs out.
+//
+// # Panics if n is negative
+//
+// Time complexity: O(n), because each dequeue is O(1) amortized
+func (q *Queue[T]) DequeueN(n int) []T {
+	if n < 0 {
+		panic("n can't be negative")
+	}
+
+	values := make([]T, 0, min(n, q.ring.len()))
+	for len(values) < n && q.ring.len() > 0 {
+		values = append(values, q.ring.popFront())
+	}
+	return values
+}
+
+// Len returns the number of values in the queue
+//
+// Time complexity: O(1), because the ring buffer keeps a count
+func (q *Queue[T]) Len() int {
+	return q.ring.len()
+}
+
+// IsEmpty returns true if there are no values in the queue
+//
+// Time complexity: O(1), because the ring buffer keeps a count
+func (q *Queue[T]) IsEmpty() bool {
+	return q.ring.len() == 0
+}
+
+// Clear removes every value from the queue
+//
+// Time complexity: O(1), because the garbage collector frees the old buffer
+func (q *Queue[T]) Clear() {
+	q.ring.clear()
+}
+
+// ToSlice copies the values of the queue into a new slice, front first.
+// Changing the slice doesn't change the queue.
+//
+// Time complexity: O(n), because it visits every value once
+func (q *Queue[T]) ToSlice() []T {
+	values := make([]T, 0, q.ring.len())
+	q.Each(func(_ int, value T) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
+// String formats the queue like a slice, front first, e.g. "[1 2 3]"
+//
+// Time complexity: O(n), because q.ToSlice is O(n)
+func (q *Queue[T]) String() string {
+	return fmt.Sprint(q.ToSlice())
 }
@@ -18,7 +18,7 @@ func TestQueue_EnqueueAddsValue(t *testing.T) {
 	want := 1
 	// Act
 	queue.Enqueue(want)
-	got := queue.list.Head.Value
+	got := *queue.ring.at(0)
 	// Assert
 	simpleAssert(t, got, want)
 }
@@ -31,7 +31,7 @@ func TestQueue_EnqueueAddsSecondValue(t *testing.T) {
 	queue.Enqueue("first added")
 	queue.Enqueue(want)
 	// Get the last value
-	got := queue.list.Tail.Value
+	got := *queue.ring.at(queue.ring.len() - 1)
 	// Assert
 	simpleAssert(t, got, want)
 }
@@ -72,6 +72,28 @@ func TestQueue_DequeueReturnsNilOnNoValues(t *testing.T) {
 	nilAssert(t, got)
 }
 
+func TestQueue_TryDequeueRemovesFirstValue(t *testing.T) {
+	// Arrange
+	queue := NewQueue[int]()
+	want := 1
+	queue.Enqueue(want)
+	queue.Enqueue(2)
+	// Act
+	got, ok := queue.TryDequeue()
+	// Assert
+	okAssert(t, got, ok, want)
+	simpleAssert(t, queue.Len(), 1)
+}
+
+func TestQueue_TryDequeueReturnsNotOkOnNoValues(t *testing.T) {
+	// Arrange
+	queue := NewQueue[int]()
+	// Act
+	_, ok := queue.TryDequeue()
+	// Assert
+	simpleAssert(t, ok, false)
+}
+
 func TestQueue_PeekReturnsFirstValue(t *testing.T) {
 	// Arrange
 	queue := NewQueue[int]()
@@ -110,6 +132,28 @@ func TestQueue_PeekReturnsNilOnNoValues(t *testing.T) {
 	nilAssert(t, got)
 }
 
+func TestQueue_TryPeekReturnsFirstValue(t *testing.T) {
+	// Arrange
+	queue := NewQueue[int]()
+	want := 1
+	queue.Enqueue(want)
+	queue.Enqueue(2)
+	// Act
+	got, ok := queue.TryPeek()
+	// Assert
+	okAssert(t, got, ok, want)
+	simpleAssert(t, queue.Len(), 2)
+}
+
+func TestQueue_TryPeekReturnsNotOkOnNoValues(t *testing.T) {
+	// Arrange
+	queue := NewQueue[int]()
+	// Act
+	_, ok := queue.TryPeek()
+	// Assert
+	simpleAssert(t, ok, false)
+}
+
 func TestQueue_GetReturnsValueAtFirstIndex(t *testing.T) {
 	// Arrange
 	queue := NewQueue[int]()
@@ -176,7 +220,7 @@ func TestQueue_ContainsReturnsTrue(t *testing.T) {
 	queue.Enqueue(0)
 	queue.Enqueue(1)
 	// Act
-	got := queue.Contains(1)
+	got := Contains(queue, 1)
 	// Assert
 	if !got {
 		t.Errorf("Expected true, got false")
@@ -189,7 +233,7 @@ func TestQueue_ContainsReturnsFalse(t *testing.T) {
 	queue.Enqueue(0)
 	queue.Enqueue(1)
 	// Act
-	got := queue.Contains(2)
+	got := Contains(queue, 2)
 	// Assert
 	if got {
 		t.Errorf("Expected false, got true")
@@ -200,14 +244,106 @@ func TestQueue_ContainsReturnsFalseOnEmptyQueue(t *testing.T) {
 	// Arrange
 	queue := NewQueue[int]()
 	// Act
-	got := queue.Contains(0)
+	got := Contains(queue, 0)
 	// Assert
 	if got {
 		t.Errorf("Expected false, got true")
 	}
 }
 
-func nilAssert[T comparable](t *testing.T, got *T) {
+func TestQueue_HoldsSlices(t *testing.T) {
+	// Arrange
+	queue := NewQueue[[]byte]()
+	queue.Enqueue([]byte("first"))
+	queue.Enqueue([]byte("second"))
+	// Act
+	found := queue.ContainsFunc(func(payload []byte) bool { return string(payload) == "second" })
+	got := *queue.Dequeue()
+	// Assert
+	simpleAssert(t, found, true)
+	simpleAssert(t, string(got), "first")
+}
+
+func TestQueue_LenAndIsEmpty(t *testing.T) {
+	// Arrange
+	queue := NewQueue[int]()
+	empty := queue.IsEmpty()
+	// Act
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	// Assert
+	simpleAssert(t, empty, true)
+	simpleAssert(t, queue.IsEmpty(), false)
+	simpleAssert(t, queue.Len(), 2)
+}
+
+func TestQueue_Clear(t *testing.T) {
+	// Arrange
+	queue := NewQueue[int]()
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	// Act
+	queue.Clear()
+	// Assert
+	simpleAssert(t, queue.Len(), 0)
+	nilAssert(t, queue.Peek())
+}
+
+func TestQueue_ToSliceAndString(t *testing.T) {
+	// Arrange
+	queue := NewQueue[int]()
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	queue.Enqueue(3)
+	// Act
+	values := queue.ToSlice()
+	values[0] = 100
+	// Assert
+	simpleAssert(t, len(values), 3)
+	simpleAssert(t, values[2], 3)
+	simpleAssert(t, *queue.Peek(), 1)
+	simpleAssert(t, queue.String(), "[1 2 3]")
+}
+
+func TestQueue_DequeueN(t *testing.T) {
+	// Arrange
+	queue := NewQueue[int]()
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	queue.Enqueue(3)
+	// Act
+	front := queue.DequeueN(2)
+	rest := queue.DequeueN(5)
+	// Assert
+	simpleAssert(t, len(front), 2)
+	simpleAssert(t, front[0], 1)
+	simpleAssert(t, front[1], 2)
+	simpleAssert(t, len(rest), 1)
+	simpleAssert(t, rest[0], 3)
+	simpleAssert(t, queue.IsEmpty(), true)
+}
+
+func TestQueue_DequeueNPanicsOnNegativeN(t *testing.T) {
+	// Arrange
+	queue := NewQueue[int]()
+	defer func() {
+		// Assert
+		if r := recover(); r == nil {
+			t.Errorf("Expected a panic")
+		}
+	}()
+	// Act
+	queue.DequeueN(-1)
+}
+
+func okAssert[T comparable](t *testing.T, got T, ok bool, want T) {
+	if !ok {
+		t.Errorf("Expected ok to be true")
+	}
+	simpleAssert(t, got, want)
+}
+
+func nilAssert[T any](t *testing.T, got *T) {
 	if got != nil {
 		t.Errorf("Expected nil, got %v", *got)
 	}
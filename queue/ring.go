@@ -0,0 +1,148 @@
+package queue
+
+// minCapacity is the smallest buffer a ring allocates, so small queues don't regrow on every few items
+const minCapacity = 8
+
+// ring is a growable circular buffer. Items start at head and wrap around the end of buf back to index 0,
+// so both ends can be added to and removed from without moving anything.
+// The zero value is an empty ring ready to use.
+type ring[T any] struct {
+	buf   []T
+	head  int
+	count int
+}
+
+// len returns the number of items in the ring
+func (r *ring[T]) len() int {
+	return r.count
+}
+
+// index turns a position from the front of the ring into an index of buf
+func (r *ring[T]) index(i int) int {
+	return (r.head + i) % len(r.buf)
+}
+
+// at returns a pointer to the item i places from the front. The pointer is only good until the ring next changes size
+//
+// Time complexity: O(1), because it's just arithmetic on the index
+func (r *ring[T]) at(i int) *T {
+	return &r.buf[r.index(i)]
+}
+
+// pushBack adds an item after the last one
+//
+// Time complexity: O(1) amortized, because the buffer doubles when it's full
+func (r *ring[T]) pushBack(value T) {
+	if r.count == len(r.buf) {
+		r.resize(max(2*len(r.buf), minCapacity))
+	}
+	r.buf[r.index(r.count)] = value
+	r.count++
+}
+
+// pushFront adds an item before the first one
+//
+// Time complexity: O(1) amortized, because the buffer doubles when it's full
+func (r *ring[T]) pushFront(value T) {
+	if r.count == len(r.buf) {
+		r.resize(max(2*len(r.buf), minCapacity))
+	}
+	r.head = (r.head - 1 + len(r.buf)) % len(r.buf)
+	r.buf[r.head] = value
+	r.count++
+}
+
+// popFront removes the first item and returns it. The ring must not be empty
+//
+// Time complexity: O(1) amortized, because the buffer only halves after shrinking to a quarter full
+func (r *ring[T]) popFront() T {
+	var zero T
+	value := r.buf[r.head]
+	// Clear the slot so the garbage collector can free whatever it pointed to
+	r.buf[r.head] = zero
+	r.head = r.index(1)
+	r.count--
+	r.shrink()
+	return value
+}
+
+// popBack removes the last item and returns it. The ring must not be empty
+//
+// Time complexity: O(1) amortized, because the buffer only halves after shrinking to a quarter full
+func (r *ring[T]) popBack() T {
+	var zero T
+	last := r.index(r.count - 1)
+	value := r.buf[last]
+	r.buf[last] = zero
+	r.count--
+	r.shrink()
+	return value
+}
+
+// each calls fn with every item from front to back, stopping early if fn returns false
+//
+// Time complexity: O(n), because it visits every item once
+func (r *ring[T]) each(fn func(index int, value T) bool) {
+	for i := 0; i < r.count; i++ {
+		if !fn(i, *r.at(i)) {
+			return
+		}
+	}
+}
+
+// removeIf removes every item the predicate returns true for, keeping the rest in order
+//
+// # Returns the number of items removed
+//
+// Time complexity: O(n), because every kept item moves at most once
+func (r *ring[T]) removeIf(predicate func(T) bool) int {
+	kept := 0
+	for i := 0; i < r.count; i++ {
+		value := *r.at(i)
+		if !predicate(value) {
+			*r.at(kept) = value
+			kept++
+		}
+	}
+
+	var zero T
+	for i := kept; i < r.count; i++ {
+		*r.at(i) = zero
+	}
+	removed := r.count - kept
+	r.count = kept
+	r.shrink()
+	return removed
+}
+
+// clear removes every item and lets go of the buffer
+//
+// Time complexity: O(1), because the garbage collector frees the old buffer
+func (r *ring[T]) clear() {
+	r.buf = nil
+	r.head = 0
+	r.count = 0
+}
+
+// shrink halves the buffer once it's only a quarter full, so a queue that was briefly huge doesn't hold onto the memory
+func (r *ring[T]) shrink() {
+	if len(r.buf) > minCapacity && r.count <= len(r.buf)/4 {
+		r.resize(len(r.buf) / 2)
+	}
+}
+
+// resize copies the items into a new buffer of the given capacity, starting at index 0
+//
+// Time complexity: O(n), because every item is copied
+func (r *ring[T]) resize(capacity int) {
+	buf := make([]T, capacity)
+	if r.head+r.count <= len(r.buf) {
+		copy(buf, r.buf[r.head:r.head+r.count])
+	} else {
+		// The items wrap around, so copy the part at the end of the old buffer, then the part at the start
+		n := copy(buf, r.buf[r.head:])
+		copy(buf[n:], r.buf[:r.count-n])
+	}
+	r.buf = buf
+	r.head = 0
+}
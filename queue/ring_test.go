@@ -0,0 +1,170 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/robertjshirts/data-structures/linked_list"
+)
+
+func TestRing_WrapsAroundWithoutGrowing(t *testing.T) {
+	// Arrange
+	var r ring[int]
+	for i := 0; i < minCapacity; i++ {
+		r.pushBack(i)
+	}
+	// Act
+	// Move the front forward, then fill the freed slots so the items wrap past the end of the buffer
+	r.popFront()
+	r.popFront()
+	r.pushBack(8)
+	r.pushBack(9)
+	// Assert
+	simpleAssert(t, len(r.buf), minCapacity)
+	simpleAssert(t, r.len(), minCapacity)
+	for i := 0; i < r.len(); i++ {
+		simpleAssert(t, *r.at(i), i+2)
+	}
+}
+
+func TestRing_GrowKeepsOrderWhenWrapped(t *testing.T) {
+	// Arrange
+	var r ring[int]
+	for i := 0; i < minCapacity; i++ {
+		r.pushBack(i)
+	}
+	r.popFront()
+	r.pushBack(minCapacity)
+	// Act
+	r.pushBack(minCapacity + 1)
+	// Assert
+	simpleAssert(t, len(r.buf), 2*minCapacity)
+	for i := 0; i < r.len(); i++ {
+		simpleAssert(t, *r.at(i), i+1)
+	}
+}
+
+func TestRing_PushFrontAndPopBack(t *testing.T) {
+	// Arrange
+	var r ring[int]
+	// Act
+	r.pushFront(2)
+	r.pushFront(1)
+	r.pushBack(3)
+	// Assert
+	simpleAssert(t, r.popBack(), 3)
+	simpleAssert(t, r.popFront(), 1)
+	simpleAssert(t, r.popBack(), 2)
+	simpleAssert(t, r.len(), 0)
+}
+
+func TestRing_ShrinksWhenMostlyEmpty(t *testing.T) {
+	// Arrange
+	var r ring[int]
+	for i := 0; i < 64; i++ {
+		r.pushBack(i)
+	}
+	// Act
+	for i := 0; i < 60; i++ {
+		r.popFront()
+	}
+	// Assert
+	simpleAssert(t, len(r.buf) < 64, true)
+	simpleAssert(t, *r.at(0), 60)
+	simpleAssert(t, *r.at(3), 63)
+}
+
+func TestRing_RemoveIfKeepsOrder(t *testing.T) {
+	// Arrange
+	var r ring[int]
+	for i := 0; i < 10; i++ {
+		r.pushFront(i)
+	}
+	// Act
+	removed := r.removeIf(func(value int) bool { return value%3 == 0 })
+	// Assert
+	simpleAssert(t, removed, 4)
+	var values []int
+	r.each(func(_ int, value int) bool {
+		values = append(values, value)
+		return true
+	})
+	simpleAssert(t, len(values), 6)
+	simpleAssert(t, values[0], 8)
+	simpleAssert(t, values[5], 1)
+}
+
+const benchmarkItems = 1000
+
+func BenchmarkQueue_EnqueueDequeue(b *testing.B) {
+	b.ReportAllocs()
+	queue := NewQueue[int]()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < benchmarkItems; j++ {
+			queue.Enqueue(j)
+		}
+		for j := 0; j < benchmarkItems; j++ {
+			queue.Dequeue()
+		}
+	}
+}
+
+func BenchmarkDoubleLinkedList_EnqueueDequeue(b *testing.B) {
+	b.ReportAllocs()
+	list := linked_list.EmptyDoubleLinkedList[int]()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < benchmarkItems; j++ {
+			list.Add(j)
+		}
+		for j := 0; j < benchmarkItems; j++ {
+			list.Remove()
+		}
+	}
+}
+
+func BenchmarkQueue_SteadyState(b *testing.B) {
+	b.ReportAllocs()
+	queue := NewQueue[int]()
+	for j := 0; j < benchmarkItems; j++ {
+		queue.Enqueue(j)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		queue.Enqueue(i)
+		queue.Dequeue()
+	}
+}
+
+func BenchmarkDoubleLinkedList_SteadyState(b *testing.B) {
+	b.ReportAllocs()
+	list := linked_list.EmptyDoubleLinkedList[int]()
+	for j := 0; j < benchmarkItems; j++ {
+		list.Add(j)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		list.Add(i)
+		list.Remove()
+	}
+}
+
+func BenchmarkQueue_Get(b *testing.B) {
+	queue := NewQueue[int]()
+	for j := 0; j < benchmarkItems; j++ {
+		queue.Enqueue(j)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		queue.Get(i % benchmarkItems)
+	}
+}
+
+func BenchmarkDoubleLinkedList_Get(b *testing.B) {
+	list := linked_list.EmptyDoubleLinkedList[int]()
+	for j := 0; j < benchmarkItems; j++ {
+		list.Add(j)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		list.Get(i % benchmarkItems)
+	}
+}
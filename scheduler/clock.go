@@ -0,0 +1,172 @@
+// Package scheduler holds containers that release values once their time comes:
+// DelayQueue for a priority heap ordered by deadline, and TimerWheel for large numbers of timers at a fixed resolution.
+// Both read the time through a Clock, so tests can pass a FakeClock and move time forward by hand.
+package scheduler
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// Clock tells the time and makes timers. RealClock uses the system clock, FakeClock only moves when it's told to
+type Clock interface {
+	Now() time.Time
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer sends the time on C once its duration has passed, like a time.Timer
+type Timer interface {
+	C() <-chan time.Time
+	// Stop prevents the timer from firing. Returns false if it already fired or was already stopped
+	Stop() bool
+}
+
+// RealClock is a Clock backed by the time package
+type RealClock struct{}
+
+// Now returns time.Now()
+func (RealClock) Now() time.Time {
+	return time.Now()
+}
+
+// NewTimer returns a Timer backed by time.NewTimer
+func (RealClock) NewTimer(d time.Duration) Timer {
+	return realTimer{time.NewTimer(d)}
+}
+
+type realTimer struct {
+	timer *time.Timer
+}
+
+func (t realTimer) C() <-chan time.Time {
+	return t.timer.C
+}
+
+func (t realTimer) Stop() bool {
+	return t.timer.Stop()
+}
+
+// FakeClock is a Clock whose time only changes when Advance is called, so tests don't have to sleep.
+// It's safe to use from many goroutines at once.
+type FakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []*fakeTimer
+	// changed is broadcast whenever a timer is added or removed, for BlockUntil
+	changed *sync.Cond
+}
+
+type fakeTimer struct {
+	clock    *FakeClock
+	deadline time.Time
+	c        chan time.Time
+}
+
+// NewFakeClock creates a clock that starts at start
+//
+// Time complexity: O(1) because we're just instantiating the struct
+func NewFakeClock(start time.Time) *FakeClock {
+	clock := &FakeClock{now: start}
+	clock.changed = sync.NewCond(&clock.mu)
+	return clock
+}
+
+// Now returns the clock's current time
+//
+// Time complexity: O(1)
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// NewTimer returns a Timer that fires once Advance moves the clock d past now. A timer with d <= 0 fires straight away
+//
+// Time complexity: O(1) amortized, because the timer is appended to a slice
+func (c *FakeClock) NewTimer(d time.Duration) Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// The channel is buffered so firing never blocks Advance, the same as time.Timer
+	timer := &fakeTimer{clock: c, deadline: c.now.Add(d), c: make(chan time.Time, 1)}
+	if d <= 0 {
+		timer.c <- c.now
+		return timer
+	}
+	c.timers = append(c.timers, timer)
+	c.changed.Broadcast()
+	return timer
+}
+
+// Advance moves the clock forward by d, firing every timer whose deadline has been reached, earliest first
+//
+// Time complexity: O(t log t), where t is the number of pending timers, because the fired timers are sorted by deadline
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+	var fired []*fakeTimer
+	c.timers = slices.DeleteFunc(c.timers, func(timer *fakeTimer) bool {
+		if timer.deadline.After(c.now) {
+			return false
+		}
+		fired = append(fired, timer)
+		return true
+	})
+	// slices.DeleteFunc leaves the removed timers in the tail, so clear it for the garbage collector
+	clear(c.timers[len(c.timers):cap(c.timers)])
+
+	slices.SortStableFunc(fired, func(a, b *fakeTimer) int {
+		return a.deadline.Compare(b.deadline)
+	})
+	for _, timer := range fired {
+		timer.c <- timer.deadline
+	}
+	if len(fired) > 0 {
+		c.changed.Broadcast()
+	}
+}
+
+// Pending returns the number of timers that haven't fired or been stopped yet
+//
+// Time complexity: O(1)
+func (c *FakeClock) Pending() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.timers)
+}
+
+// BlockUntil waits until at least n timers are pending. Tests use it to know another goroutine is waiting on the clock before calling Advance
+//
+// Time complexity: O(1) per timer added or removed while waiting
+func (c *FakeClock) BlockUntil(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for len(c.timers) < n {
+		c.changed.Wait()
+	}
+}
+
+func (t *fakeTimer) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *fakeTimer) Stop() bool {
+	c := t.clock
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	i := slices.Index(c.timers, t)
+	if i == -1 {
+		return false
+	}
+	// Shift the rest down instead of swapping with the last one, so timers with the same deadline keep their order
+	last := len(c.timers) - 1
+	copy(c.timers[i:], c.timers[i+1:])
+	c.timers[last] = nil
+	c.timers = c.timers[:last]
+	c.changed.Broadcast()
+	return true
+}
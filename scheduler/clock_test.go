@@ -0,0 +1,103 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+)
+
+var epoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestFakeClock_AdvanceFiresDueTimers(t *testing.T) {
+	// Arrange
+	clock := NewFakeClock(epoch)
+	early := clock.NewTimer(time.Second)
+	late := clock.NewTimer(time.Minute)
+	// Act
+	clock.Advance(2 * time.Second)
+	// Assert
+	simpleAssert(t, fired(early), true)
+	simpleAssert(t, fired(late), false)
+	simpleAssert(t, clock.Now(), epoch.Add(2*time.Second))
+	simpleAssert(t, clock.Pending(), 1)
+}
+
+func TestFakeClock_StopPreventsFiring(t *testing.T) {
+	// Arrange
+	clock := NewFakeClock(epoch)
+	timer := clock.NewTimer(time.Second)
+	// Act
+	stopped := timer.Stop()
+	clock.Advance(time.Second)
+	// Assert
+	simpleAssert(t, stopped, true)
+	simpleAssert(t, timer.Stop(), false)
+	simpleAssert(t, fired(timer), false)
+	simpleAssert(t, clock.Pending(), 0)
+}
+
+func TestFakeClock_NonPositiveDurationFiresStraightAway(t *testing.T) {
+	// Arrange
+	clock := NewFakeClock(epoch)
+	// Act
+	timer := clock.NewTimer(0)
+	// Assert
+	simpleAssert(t, fired(timer), true)
+	simpleAssert(t, clock.Pending(), 0)
+}
+
+func TestFakeClock_BlockUntilWaitsForTimers(t *testing.T) {
+	// Arrange
+	clock := NewFakeClock(epoch)
+	done := make(chan struct{})
+	// Act
+	go func() {
+		clock.BlockUntil(2)
+		close(done)
+	}()
+	clock.NewTimer(time.Second)
+	clock.NewTimer(time.Second)
+	// Assert
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("BlockUntil didn't return after 2 timers were made")
+	}
+}
+
+func TestRealClock_TimerFires(t *testing.T) {
+	// Arrange
+	clock := RealClock{}
+	// Act
+	timer := clock.NewTimer(time.Millisecond)
+	// Assert
+	select {
+	case <-timer.C():
+	case <-time.After(time.Second):
+		t.Fatalf("Timer didn't fire")
+	}
+}
+
+// fired returns true if the timer has sent on its channel, without waiting
+func fired(timer Timer) bool {
+	select {
+	case <-timer.C():
+		return true
+	default:
+		return false
+	}
+}
+
+func simpleAssert[T comparable](t *testing.T, got, want T) {
+	t.Helper()
+	if got != want {
+		t.Errorf("Got %v, wanted %v", got, want)
+	}
+}
+
+func okAssert[T comparable](t *testing.T, got T, ok bool, want T) {
+	t.Helper()
+	if !ok {
+		t.Errorf("Expected ok to be true")
+	}
+	simpleAssert(t, got, want)
+}
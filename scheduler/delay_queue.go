@@ -0,0 +1,187 @@
+package scheduler
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+)
+
+// DelayQueue holds values until their deadline, then hands them out earliest deadline first.
+// Values with the same deadline come out in the order they were put in.
+// It's safe to use from many goroutines at once, and any number of them can wait in Take.
+type DelayQueue[T any] struct {
+	mu    sync.Mutex
+	items delayHeap[T]
+	clock Clock
+	// seq numbers each item as it's put in, so equal deadlines keep their order
+	seq uint64
+	// front is closed to wake up Take when a new item goes to the front of the heap, then replaced for the next wait.
+	// The waiter count lets us skip that when nobody's waiting
+	front   chan struct{}
+	waiters int
+}
+
+type delayItem[T any] struct {
+	value    T
+	deadline time.Time
+	seq      uint64
+}
+
+// delayHeap is a min-heap of items by deadline, for container/heap
+type delayHeap[T any] []delayItem[T]
+
+func (h delayHeap[T]) Len() int {
+	return len(h)
+}
+
+func (h delayHeap[T]) Less(i, j int) bool {
+	if c := h[i].deadline.Compare(h[j].deadline); c != 0 {
+		return c < 0
+	}
+	return h[i].seq < h[j].seq
+}
+
+func (h delayHeap[T]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+func (h *delayHeap[T]) Push(item any) {
+	*h = append(*h, item.(delayItem[T]))
+}
+
+func (h *delayHeap[T]) Pop() any {
+	old := *h
+	last := len(old) - 1
+	item := old[last]
+	// Clear the slot so the garbage collector can free whatever the value pointed to
+	old[last] = delayItem[T]{}
+	*h = old[:last]
+	return item
+}
+
+// NewDelayQueue creates an empty queue that reads the time from clock
+//
+// # Panics if clock is nil
+//
+// Time complexity: O(1) because we're just instantiating the struct
+func NewDelayQueue[T any](clock Clock) *DelayQueue[T] {
+	if clock == nil {
+		panic("Clock can't be nil")
+	}
+	return &DelayQueue[T]{
+		clock: clock,
+		front: make(chan struct{}),
+	}
+}
+
+// Put adds a value that's released once the clock reaches deadline. A deadline in the past is released straight away
+//
+// Time complexity: O(log n) because pushing onto the heap is O(log n)
+func (q *DelayQueue[T]) Put(value T, deadline time.Time) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	heap.Push(&q.items, delayItem[T]{value: value, deadline: deadline, seq: q.seq})
+	q.seq++
+	// Only a new front changes how long Take has to wait
+	if q.items[0].seq == q.seq-1 && q.waiters > 0 {
+		close(q.front)
+		q.front = make(chan struct{})
+	}
+}
+
+// PutAfter adds a value that's released once delay has passed on the clock
+//
+// Time complexity: O(log n) because q.Put is O(log n)
+func (q *DelayQueue[T]) PutAfter(value T, delay time.Duration) {
+	q.Put(value, q.clock.Now().Add(delay))
+}
+
+// Poll removes and returns the front value if its deadline has passed, without waiting
+//
+// # Returns the value and true, or the zero value and false if the queue is empty or nothing is due yet
+//
+// Time complexity: O(log n) because popping from the heap is O(log n)
+func (q *DelayQueue[T]) Poll() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.items) == 0 || q.items[0].deadline.After(q.clock.Now()) {
+		var zero T
+		return zero, false
+	}
+	return heap.Pop(&q.items).(delayItem[T]).value, true
+}
+
+// Take removes and returns the front value, waiting until its deadline has passed.
+// If a value with an earlier deadline is put in while waiting, Take waits for that one instead.
+//
+// # Returns ctx.Err() if ctx is done before a value is due
+//
+// Time complexity: O(log n) because popping from the heap is O(log n), not counting the wait
+func (q *DelayQueue[T]) Take(ctx context.Context) (T, error) {
+	for {
+		q.mu.Lock()
+		var timer Timer
+		var due <-chan time.Time
+		if len(q.items) > 0 {
+			delay := q.items[0].deadline.Sub(q.clock.Now())
+			if delay <= 0 {
+				value := heap.Pop(&q.items).(delayItem[T]).value
+				q.mu.Unlock()
+				return value, nil
+			}
+			timer = q.clock.NewTimer(delay)
+			due = timer.C()
+		}
+		front := q.front
+		q.waiters++
+		q.mu.Unlock()
+
+		// A nil due channel blocks forever, so an empty queue only waits for a Put or ctx
+		var err error
+		select {
+		case <-due:
+		case <-front:
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+
+		q.mu.Lock()
+		q.waiters--
+		q.mu.Unlock()
+		if err != nil {
+			var zero T
+			return zero, err
+		}
+	}
+}
+
+// Peek returns the front value and its deadline without removing it, whether or not it's due
+//
+// # Returns the value, its deadline and true, or zero values and false if the queue is empty
+//
+// Time complexity: O(1) because the front of the heap is the first item
+func (q *DelayQueue[T]) Peek() (T, time.Time, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.items) == 0 {
+		var zero T
+		return zero, time.Time{}, false
+	}
+	return q.items[0].value, q.items[0].deadline, true
+}
+
+// Len returns the number of values in the queue, due or not
+//
+// Time complexity: O(1) because it's the length of the heap
+func (q *DelayQueue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.items)
+}
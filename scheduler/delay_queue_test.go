@@ -0,0 +1,177 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestDelayQueue_PollWaitsForDeadline(t *testing.T) {
+	// Arrange
+	clock := NewFakeClock(epoch)
+	queue := NewDelayQueue[string](clock)
+	queue.PutAfter("later", 2*time.Second)
+	queue.PutAfter("sooner", time.Second)
+	// Act
+	_, beforeOk := queue.Poll()
+	clock.Advance(time.Second)
+	first, firstOk := queue.Poll()
+	_, secondOk := queue.Poll()
+	// Assert
+	simpleAssert(t, beforeOk, false)
+	okAssert(t, first, firstOk, "sooner")
+	simpleAssert(t, secondOk, false)
+	simpleAssert(t, queue.Len(), 1)
+}
+
+func TestDelayQueue_EqualDeadlinesKeepTheirOrder(t *testing.T) {
+	// Arrange
+	clock := NewFakeClock(epoch)
+	queue := NewDelayQueue[int](clock)
+	for i := 0; i < 10; i++ {
+		queue.Put(i, epoch)
+	}
+	// Act & Assert
+	for i := 0; i < 10; i++ {
+		value, ok := queue.Poll()
+		okAssert(t, value, ok, i)
+	}
+}
+
+func TestDelayQueue_Peek(t *testing.T) {
+	// Arrange
+	clock := NewFakeClock(epoch)
+	queue := NewDelayQueue[string](clock)
+	deadline := epoch.Add(time.Minute)
+	// Act
+	_, _, emptyOk := queue.Peek()
+	queue.Put("a", deadline)
+	value, gotDeadline, ok := queue.Peek()
+	// Assert
+	simpleAssert(t, emptyOk, false)
+	okAssert(t, value, ok, "a")
+	simpleAssert(t, gotDeadline, deadline)
+	simpleAssert(t, queue.Len(), 1)
+}
+
+func TestDelayQueue_TakeBlocksUntilDeadline(t *testing.T) {
+	// Arrange
+	clock := NewFakeClock(epoch)
+	queue := NewDelayQueue[string](clock)
+	queue.PutAfter("retry", time.Minute)
+	result := make(chan string)
+	// Act
+	go func() {
+		value, _ := queue.Take(context.Background())
+		result <- value
+	}()
+	clock.BlockUntil(1)
+	clock.Advance(59 * time.Second)
+	// Assert
+	select {
+	case value := <-result:
+		t.Fatalf("Take returned %q before the deadline", value)
+	default:
+	}
+	clock.Advance(time.Second)
+	simpleAssert(t, <-result, "retry")
+}
+
+func TestDelayQueue_TakeWakesForEarlierPut(t *testing.T) {
+	// Arrange
+	clock := NewFakeClock(epoch)
+	queue := NewDelayQueue[string](clock)
+	queue.PutAfter("late", time.Hour)
+	result := make(chan string)
+	go func() {
+		value, _ := queue.Take(context.Background())
+		result <- value
+	}()
+	clock.BlockUntil(1)
+	// Act
+	queue.PutAfter("early", time.Second)
+	// The hour long timer can't fire, so only the Put can wake Take up to see "early" is due
+	clock.Advance(time.Second)
+	// Assert
+	simpleAssert(t, <-result, "early")
+	simpleAssert(t, queue.Len(), 1)
+}
+
+func TestDelayQueue_TakeWaitsOnEmptyQueue(t *testing.T) {
+	// Arrange
+	clock := NewFakeClock(epoch)
+	queue := NewDelayQueue[int](clock)
+	result := make(chan int)
+	go func() {
+		value, _ := queue.Take(context.Background())
+		result <- value
+	}()
+	// Act
+	queue.Put(7, epoch)
+	// Assert
+	simpleAssert(t, <-result, 7)
+}
+
+func TestDelayQueue_TakeReturnsContextError(t *testing.T) {
+	// Arrange
+	clock := NewFakeClock(epoch)
+	queue := NewDelayQueue[int](clock)
+	queue.PutAfter(1, time.Hour)
+	ctx, cancel := context.WithCancel(context.Background())
+	// Act
+	cancel()
+	_, err := queue.Take(ctx)
+	// Assert
+	simpleAssert(t, errors.Is(err, context.Canceled), true)
+	simpleAssert(t, queue.Len(), 1)
+	simpleAssert(t, clock.Pending(), 0)
+}
+
+func TestDelayQueue_ConcurrentTakers(t *testing.T) {
+	// Arrange
+	queue := NewDelayQueue[int](RealClock{})
+	const takers, perTaker = 4, 50
+	var wg sync.WaitGroup
+	taken := make([][]int, takers)
+	// Act
+	for w := 0; w < takers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perTaker; i++ {
+				value, err := queue.Take(context.Background())
+				if err != nil {
+					t.Errorf("Take failed: %v", err)
+					return
+				}
+				taken[w] = append(taken[w], value)
+			}
+		}(w)
+	}
+	for i := 0; i < takers*perTaker; i++ {
+		queue.PutAfter(i, time.Duration(i%5)*time.Millisecond)
+	}
+	wg.Wait()
+	// Assert
+	seen := make([]bool, takers*perTaker)
+	for _, values := range taken {
+		for _, value := range values {
+			simpleAssert(t, seen[value], false)
+			seen[value] = true
+		}
+	}
+	simpleAssert(t, queue.Len(), 0)
+}
+
+func TestNewDelayQueue_PanicsOnNilClock(t *testing.T) {
+	// Arrange
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected a panic")
+		}
+	}()
+	// Act
+	NewDelayQueue[int](nil)
+}
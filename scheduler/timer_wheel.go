@@ -0,0 +1,239 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/robertjshirts/data-structures/linked_list"
+)
+
+// TimerWheel fires large numbers of timers at a fixed resolution, the tick.
+// Each level is a ring of slots, and each slot is a linked list of the timers due in it.
+// A slot on level 0 covers one tick, and a slot on each level up covers a whole turn of the level below,
+// so with s slots and l levels the wheel can hold timers s^l ticks ahead while only ever touching one slot per level per tick.
+// When a higher level's slot comes up, its timers cascade down to the level that fits how long they have left.
+// Timers further out than the wheel reaches wait in the top level and cascade around it until they're in range.
+//
+// Scheduling is O(levels) and cancelling is O(1), where DelayQueue.Put is O(log n), at the cost of rounding deadlines up to the next tick.
+// It's safe to use from many goroutines at once.
+type TimerWheel[T any] struct {
+	mu    sync.Mutex
+	clock Clock
+	start time.Time
+	tick  time.Duration
+	slots int
+	// levels[l][i] holds the timers in slot i of level l
+	levels [][]linked_list.DoubleLinkedList[*WheelTimer[T]]
+	// current is the last tick that's been processed, counting from start
+	current int64
+	count   int
+}
+
+// WheelTimer is a timer scheduled on a TimerWheel, which can be used to cancel it
+type WheelTimer[T any] struct {
+	wheel *TimerWheel[T]
+	value T
+	// expires is the tick the timer fires on, counting from the wheel's start
+	expires int64
+	// cursor points at the timer's node in its slot, so Cancel can unlink it in O(1).
+	// It's nil once the timer fires or is cancelled, and while it's between slots
+	cursor *linked_list.DoubleCursor[*WheelTimer[T]]
+}
+
+// NewTimerWheel creates an empty wheel that reads the time from clock, rounding deadlines up to a multiple of tick
+//
+// # Panics if clock is nil, tick isn't positive, slots is less than 2 or levels is less than 1
+//
+// Time complexity: O(slots * levels) because every slot gets an empty list
+func NewTimerWheel[T any](clock Clock, tick time.Duration, slots, levels int) *TimerWheel[T] {
+	if clock == nil {
+		panic("Clock can't be nil")
+	}
+	if tick <= 0 {
+		panic("Tick must be positive")
+	}
+	if slots < 2 || levels < 1 {
+		panic("A wheel needs at least 2 slots and 1 level")
+	}
+
+	wheel := &TimerWheel[T]{
+		clock:  clock,
+		start:  clock.Now(),
+		tick:   tick,
+		slots:  slots,
+		levels: make([][]linked_list.DoubleLinkedList[*WheelTimer[T]], levels),
+	}
+	for l := range wheel.levels {
+		wheel.levels[l] = make([]linked_list.DoubleLinkedList[*WheelTimer[T]], slots)
+	}
+	return wheel
+}
+
+// Schedule adds a timer that fires with value once the clock reaches deadline, rounded up to the next tick.
+// A deadline that has already passed fires on the next tick.
+//
+// # Returns the timer, so it can be cancelled
+//
+// Time complexity: O(levels) to pick the level, then O(1) to add to the slot
+func (w *TimerWheel[T]) Schedule(value T, deadline time.Time) *WheelTimer[T] {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	// Round up, so a timer never fires before its deadline
+	elapsed := deadline.Sub(w.start)
+	expires := int64(elapsed / w.tick)
+	if elapsed%w.tick > 0 {
+		expires++
+	}
+
+	timer := &WheelTimer[T]{wheel: w, value: value, expires: max(expires, w.current+1)}
+	w.add(timer)
+	w.count++
+	return timer
+}
+
+// ScheduleAfter adds a timer that fires with value once delay has passed on the clock
+//
+// # Returns the timer, so it can be cancelled
+//
+// Time complexity: O(levels) because w.Schedule is O(levels)
+func (w *TimerWheel[T]) ScheduleAfter(value T, delay time.Duration) *WheelTimer[T] {
+	return w.Schedule(value, w.clock.Now().Add(delay))
+}
+
+// Cancel stops the timer from firing
+//
+// # Returns true if the timer was stopped, false if it already fired or was already cancelled
+//
+// Time complexity: O(1) because the cursor unlinks the timer from its slot directly
+func (t *WheelTimer[T]) Cancel() bool {
+	w := t.wheel
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if t.cursor == nil {
+		return false
+	}
+	t.cursor.Remove()
+	t.cursor = nil
+	w.count--
+	return true
+}
+
+// Advance processes every tick up to the clock's current time, calling fn with the value of each timer that fires.
+// Timers fire in order of their tick, but timers on the same tick can fire in any order.
+// fn is called without the lock held, so it may schedule or cancel timers.
+//
+// Time complexity: O(ticks * levels + fired), where ticks is the number of ticks since the last Advance.
+// If there are no timers, the wheel skips straight to the current tick
+func (w *TimerWheel[T]) Advance(fn func(value T)) {
+	w.mu.Lock()
+	target := int64(w.clock.Now().Sub(w.start) / w.tick)
+	var fired []T
+	for w.current < target {
+		if w.count == 0 {
+			w.current = target
+			break
+		}
+		w.current++
+		fired = w.step(fired)
+	}
+	w.mu.Unlock()
+
+	for _, value := range fired {
+		fn(value)
+	}
+}
+
+// Run calls Advance once a tick until ctx is done
+//
+// # Returns ctx.Err()
+func (w *TimerWheel[T]) Run(ctx context.Context, fn func(value T)) error {
+	for {
+		timer := w.clock.NewTimer(w.tick)
+		select {
+		case <-timer.C():
+			w.Advance(fn)
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// Len returns the number of timers that haven't fired or been cancelled
+//
+// Time complexity: O(1) because we keep a count
+func (w *TimerWheel[T]) Len() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.count
+}
+
+// step processes tick w.current: it cascades any higher level slots that come up on this tick, then fires level 0's slot.
+// Fired values are appended to fired, which is returned
+func (w *TimerWheel[T]) step(fired []T) []T {
+	// Cascade from the top down, so timers can fall more than one level in a single tick
+	for l := len(w.levels) - 1; l > 0; l-- {
+		span := w.span(l)
+		if w.current%span != 0 {
+			continue
+		}
+		for _, timer := range w.take(l, int(w.current/span%int64(w.slots))) {
+			w.add(timer)
+		}
+	}
+
+	for _, timer := range w.take(0, int(w.current%int64(w.slots))) {
+		// A timer past the wheel's reach can come up a turn early, so it goes around again
+		if timer.expires > w.current {
+			w.add(timer)
+			continue
+		}
+		w.count--
+		fired = append(fired, timer.value)
+	}
+	return fired
+}
+
+// add puts a timer in the lowest level whose turn reaches its tick, or the top level if none do
+func (w *TimerWheel[T]) add(timer *WheelTimer[T]) {
+	delta := timer.expires - w.current
+	level := 0
+	for level < len(w.levels)-1 && delta >= w.span(level+1) {
+		level++
+	}
+
+	span := w.span(level)
+	bucket := &w.levels[level][timer.expires/span%int64(w.slots)]
+	bucket.Add(timer)
+	timer.cursor = bucket.Back()
+}
+
+// take empties a slot and returns the timers in it, in the order they were added.
+// Their cursors are cleared, because clearing the slot leaves them pointing at nodes that aren't in the list anymore
+func (w *TimerWheel[T]) take(level, slot int) []*WheelTimer[T] {
+	bucket := &w.levels[level][slot]
+	if bucket.Count == 0 {
+		return nil
+	}
+
+	timers := make([]*WheelTimer[T], 0, bucket.Count)
+	bucket.Each(func(_ int, timer *WheelTimer[T]) bool {
+		timer.cursor = nil
+		timers = append(timers, timer)
+		return true
+	})
+	bucket.Clear()
+	return timers
+}
+
+// span returns how many ticks one slot on a level covers, which is slots^level
+func (w *TimerWheel[T]) span(level int) int64 {
+	span := int64(1)
+	for i := 0; i < level; i++ {
+		span *= int64(w.slots)
+	}
+	return span
+}
@@ -0,0 +1,265 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestTimerWheel_FiresOnTheTickOfItsDeadline(t *testing.T) {
+	// Arrange
+	clock := NewFakeClock(epoch)
+	wheel := NewTimerWheel[string](clock, time.Second, 8, 2)
+	wheel.ScheduleAfter("a", 3*time.Second)
+	// Act
+	var fired []string
+	record := func(value string) { fired = append(fired, value) }
+	clock.Advance(2 * time.Second)
+	wheel.Advance(record)
+	before := len(fired)
+	clock.Advance(time.Second)
+	wheel.Advance(record)
+	// Assert
+	simpleAssert(t, before, 0)
+	sliceAssert(t, fired, []string{"a"})
+	simpleAssert(t, wheel.Len(), 0)
+}
+
+func TestTimerWheel_RoundsDeadlinesUp(t *testing.T) {
+	// Arrange
+	clock := NewFakeClock(epoch)
+	wheel := NewTimerWheel[int](clock, time.Second, 8, 1)
+	wheel.ScheduleAfter(1, 1500*time.Millisecond)
+	count := 0
+	// Act
+	clock.Advance(time.Second)
+	wheel.Advance(func(int) { count++ })
+	firstCount := count
+	clock.Advance(time.Second)
+	wheel.Advance(func(int) { count++ })
+	// Assert
+	simpleAssert(t, firstCount, 0)
+	simpleAssert(t, count, 1)
+}
+
+func TestTimerWheel_PastDeadlineFiresOnNextTick(t *testing.T) {
+	// Arrange
+	clock := NewFakeClock(epoch)
+	wheel := NewTimerWheel[int](clock, time.Second, 4, 2)
+	clock.Advance(10 * time.Second)
+	wheel.Advance(func(int) {})
+	wheel.Schedule(1, epoch)
+	count := 0
+	// Act
+	clock.Advance(time.Second)
+	wheel.Advance(func(int) { count++ })
+	// Assert
+	simpleAssert(t, count, 1)
+}
+
+func TestTimerWheel_Cancel(t *testing.T) {
+	// Arrange
+	clock := NewFakeClock(epoch)
+	wheel := NewTimerWheel[int](clock, time.Second, 4, 2)
+	timer := wheel.ScheduleAfter(1, 10*time.Second)
+	wheel.ScheduleAfter(2, 10*time.Second)
+	// Act
+	cancelled := timer.Cancel()
+	var fired []int
+	clock.Advance(10 * time.Second)
+	wheel.Advance(func(value int) { fired = append(fired, value) })
+	// Assert
+	simpleAssert(t, cancelled, true)
+	simpleAssert(t, timer.Cancel(), false)
+	sliceAssert(t, fired, []int{2})
+}
+
+func TestTimerWheel_CancelRemovesTimerFromItsSlot(t *testing.T) {
+	// Arrange
+	clock := NewFakeClock(epoch)
+	wheel := NewTimerWheel[int](clock, time.Second, 4, 2)
+	first := wheel.ScheduleAfter(1, 10*time.Second)
+	second := wheel.ScheduleAfter(2, 2*time.Second)
+	// Act
+	first.Cancel()
+	second.Cancel()
+	// Assert
+	simpleAssert(t, wheel.Len(), 0)
+	simpleAssert(t, slotted(wheel), 0)
+}
+
+func TestTimerWheel_CancelAfterCascade(t *testing.T) {
+	// Arrange
+	clock := NewFakeClock(epoch)
+	wheel := NewTimerWheel[int](clock, time.Second, 4, 2)
+	timer := wheel.ScheduleAfter(1, 10*time.Second)
+	wheel.ScheduleAfter(2, 10*time.Second)
+	clock.Advance(8 * time.Second)
+	wheel.Advance(func(int) {})
+	// Act
+	cancelled := timer.Cancel()
+	var fired []int
+	clock.Advance(2 * time.Second)
+	wheel.Advance(func(value int) { fired = append(fired, value) })
+	// Assert
+	simpleAssert(t, cancelled, true)
+	sliceAssert(t, fired, []int{2})
+	simpleAssert(t, slotted(wheel), 0)
+}
+
+func TestTimerWheel_CancelAfterFiring(t *testing.T) {
+	// Arrange
+	clock := NewFakeClock(epoch)
+	wheel := NewTimerWheel[int](clock, time.Second, 4, 2)
+	timer := wheel.ScheduleAfter(1, time.Second)
+	clock.Advance(time.Second)
+	wheel.Advance(func(int) {})
+	// Act
+	cancelled := timer.Cancel()
+	// Assert
+	simpleAssert(t, cancelled, false)
+	simpleAssert(t, wheel.Len(), 0)
+}
+
+func TestTimerWheel_FiresTimersBeyondItsReach(t *testing.T) {
+	// Arrange
+	clock := NewFakeClock(epoch)
+	// 4 slots and 2 levels only reach 16 ticks ahead
+	wheel := NewTimerWheel[int](clock, time.Second, 4, 2)
+	wheel.ScheduleAfter(1, 50*time.Second)
+	var firedAt []time.Duration
+	// Act
+	for i := 0; i < 60; i++ {
+		clock.Advance(time.Second)
+		wheel.Advance(func(int) { firedAt = append(firedAt, clock.Now().Sub(epoch)) })
+	}
+	// Assert
+	sliceAssert(t, firedAt, []time.Duration{50 * time.Second})
+}
+
+func TestTimerWheel_MatchesExactDeadlines(t *testing.T) {
+	// Arrange
+	clock := NewFakeClock(epoch)
+	wheel := NewTimerWheel[int](clock, time.Second, 4, 3)
+	rng := rand.New(rand.NewSource(1))
+	const timers, ticks = 500, 200
+	expected := make([]int, timers)
+	for i := range expected {
+		expected[i] = 1 + rng.Intn(ticks-1)
+		wheel.ScheduleAfter(i, time.Duration(expected[i])*time.Second)
+	}
+	got := make([]int, timers)
+	// Act
+	for tick := 1; tick <= ticks; tick++ {
+		// Jump more than one tick sometimes, so cascades happen inside a single Advance
+		if tick%7 == 0 {
+			continue
+		}
+		clock.Advance(time.Duration(tick)*time.Second - clock.Now().Sub(epoch))
+		wheel.Advance(func(value int) { got[value] = tick })
+	}
+	// Assert
+	for i := range expected {
+		want := expected[i]
+		if want%7 == 0 {
+			// Skipped ticks are processed on the next Advance
+			want++
+		}
+		if got[i] != want {
+			t.Fatalf("Timer %d due at tick %d fired at tick %d", i, expected[i], got[i])
+		}
+	}
+	simpleAssert(t, wheel.Len(), 0)
+}
+
+func TestTimerWheel_Run(t *testing.T) {
+	// Arrange
+	clock := NewFakeClock(epoch)
+	wheel := NewTimerWheel[string](clock, time.Second, 8, 2)
+	wheel.ScheduleAfter("a", 2*time.Second)
+	fired := make(chan string, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- wheel.Run(ctx, func(value string) { fired <- value })
+	}()
+	// Act
+	for i := 0; i < 2; i++ {
+		clock.BlockUntil(1)
+		clock.Advance(time.Second)
+	}
+	value := <-fired
+	cancel()
+	// Assert
+	simpleAssert(t, value, "a")
+	simpleAssert(t, errors.Is(<-done, context.Canceled), true)
+}
+
+func TestNewTimerWheel_PanicsOnBadArguments(t *testing.T) {
+	tests := []struct {
+		name   string
+		clock  Clock
+		tick   time.Duration
+		slots  int
+		levels int
+	}{
+		{"nil clock", nil, time.Second, 8, 1},
+		{"zero tick", RealClock{}, 0, 8, 1},
+		{"one slot", RealClock{}, time.Second, 1, 1},
+		{"no levels", RealClock{}, time.Second, 8, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Expected a panic")
+				}
+			}()
+			// Act
+			NewTimerWheel[int](test.clock, test.tick, test.slots, test.levels)
+		})
+	}
+}
+
+func BenchmarkTimerWheel_Schedule(b *testing.B) {
+	b.ReportAllocs()
+	clock := NewFakeClock(epoch)
+	wheel := NewTimerWheel[int](clock, time.Millisecond, 256, 4)
+	for i := 0; i < b.N; i++ {
+		wheel.ScheduleAfter(i, time.Duration(i%100000)*time.Millisecond)
+	}
+}
+
+func BenchmarkDelayQueue_Put(b *testing.B) {
+	b.ReportAllocs()
+	queue := NewDelayQueue[int](NewFakeClock(epoch))
+	for i := 0; i < b.N; i++ {
+		queue.PutAfter(i, time.Duration(i%100000)*time.Millisecond)
+	}
+}
+
+// slotted counts the timers sitting in the wheel's slots, cancelled or not
+func slotted[T any](wheel *TimerWheel[T]) int {
+	count := 0
+	for _, level := range wheel.levels {
+		for _, bucket := range level {
+			count += bucket.Count
+		}
+	}
+	return count
+}
+
+func sliceAssert[T comparable](t *testing.T, got, want []T) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("Got %v, wanted %v", got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Fatalf("Got %v, wanted %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,295 @@
+package skip_list
+
+import (
+	"cmp"
+	"math/rand"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+type concurrentNode[K cmp.Ordered, V any] struct {
+	key   K
+	value atomic.Pointer[V]
+	next  []atomic.Pointer[concurrentNode[K, V]]
+	// mu guards changes to next, and to marked for this node
+	mu sync.Mutex
+	// marked means the node is being deleted, fullyLinked means it's on every one of its levels
+	marked      atomic.Bool
+	fullyLinked atomic.Bool
+}
+
+// ConcurrentSkipList is a SkipList that's safe to use from many goroutines at once.
+// It's a lazy skip list: Insert and Delete only lock the few nodes next to the key they change,
+// and Search, Floor, Ceiling and Range don't lock at all.
+//
+// Range and Each are weakly consistent. They see every key that was there for the whole walk,
+// and might or might not see keys inserted or deleted while walking.
+type ConcurrentSkipList[K cmp.Ordered, V any] struct {
+	head  *concurrentNode[K, V]
+	count atomic.Int64
+	// rng isn't safe for concurrent use, so it gets its own lock
+	rngMu sync.Mutex
+	rng   *rand.Rand
+}
+
+// NewConcurrentSkipList creates an empty concurrent skip list. The seed picks the level of every inserted key
+//
+// Time complexity: O(1) because we're just instantiating the head
+func NewConcurrentSkipList[K cmp.Ordered, V any](seed int64) *ConcurrentSkipList[K, V] {
+	head := &concurrentNode[K, V]{next: make([]atomic.Pointer[concurrentNode[K, V]], MaxLevel)}
+	head.fullyLinked.Store(true)
+	return &ConcurrentSkipList[K, V]{
+		head: head,
+		rng:  rand.New(rand.NewSource(seed)),
+	}
+}
+
+// Len returns the number of keys in the list
+//
+// Time complexity: O(1) because we keep a count
+func (s *ConcurrentSkipList[K, V]) Len() int {
+	return int(s.count.Load())
+}
+
+// Insert sets the value for a key, adding the key if it isn't in the list yet
+//
+// Returns true if the key was added, false if it was already there and only the value changed.
+//
+// Time complexity: O(log n) on average, plus retries when another goroutine changes the same spot
+func (s *ConcurrentSkipList[K, V]) Insert(key K, value V) bool {
+	level := s.randomLevel()
+	var preds, succs [MaxLevel]*concurrentNode[K, V]
+	for {
+		if found := s.find(key, &preds, &succs); found != -1 {
+			existing := succs[found]
+			if existing.marked.Load() {
+				// It's being deleted, so try again once it's gone
+				runtime.Gosched()
+				continue
+			}
+			// Wait for the other insert to finish linking it
+			for !existing.fullyLinked.Load() {
+				runtime.Gosched()
+			}
+			existing.value.Store(&value)
+			return false
+		}
+
+		// Lock the node before the key on each level, and check nothing changed since find
+		highestLocked := -1
+		valid := true
+		for i := 0; valid && i < level; i++ {
+			pred, succ := preds[i], succs[i]
+			if i == 0 || pred != preds[i-1] {
+				pred.mu.Lock()
+			}
+			highestLocked = i
+			valid = !pred.marked.Load() && (succ == nil || !succ.marked.Load()) && pred.next[i].Load() == succ
+		}
+		if !valid {
+			unlockPreds(&preds, highestLocked)
+			continue
+		}
+
+		newNode := &concurrentNode[K, V]{key: key, next: make([]atomic.Pointer[concurrentNode[K, V]], level)}
+		newNode.value.Store(&value)
+		for i := 0; i < level; i++ {
+			newNode.next[i].Store(succs[i])
+		}
+		for i := 0; i < level; i++ {
+			preds[i].next[i].Store(newNode)
+		}
+		newNode.fullyLinked.Store(true)
+		unlockPreds(&preds, highestLocked)
+		s.count.Add(1)
+		return true
+	}
+}
+
+// Delete removes a key from the list
+//
+// Returns the key's value and true, or the zero value and false if the key wasn't there.
+//
+// Time complexity: O(log n) on average, plus retries when another goroutine changes the same spot
+func (s *ConcurrentSkipList[K, V]) Delete(key K) (V, bool) {
+	var preds, succs [MaxLevel]*concurrentNode[K, V]
+	var victim *concurrentNode[K, V]
+	for {
+		found := s.find(key, &preds, &succs)
+		if victim == nil {
+			if found == -1 || !canDelete(succs[found], found) {
+				var zero V
+				return zero, false
+			}
+
+			// Marking the victim is what makes the delete happen, the unlinking below just tidies up
+			victim = succs[found]
+			victim.mu.Lock()
+			if victim.marked.Load() {
+				victim.mu.Unlock()
+				var zero V
+				return zero, false
+			}
+			victim.marked.Store(true)
+		}
+
+		level := len(victim.next)
+		highestLocked := -1
+		valid := true
+		for i := 0; valid && i < level; i++ {
+			pred := preds[i]
+			if i == 0 || pred != preds[i-1] {
+				pred.mu.Lock()
+			}
+			highestLocked = i
+			valid = !pred.marked.Load() && pred.next[i].Load() == victim
+		}
+		if !valid {
+			unlockPreds(&preds, highestLocked)
+			continue
+		}
+
+		for i := level - 1; i >= 0; i-- {
+			preds[i].next[i].Store(victim.next[i].Load())
+		}
+		victim.mu.Unlock()
+		unlockPreds(&preds, highestLocked)
+		s.count.Add(-1)
+		return *victim.value.Load(), true
+	}
+}
+
+// Search finds the value for a key, without locking
+//
+// Returns the value and true, or the zero value and false if the key isn't in the list.
+//
+// Time complexity: O(log n) on average, because each level skips about half the keys of the one below
+func (s *ConcurrentSkipList[K, V]) Search(key K) (V, bool) {
+	var preds, succs [MaxLevel]*concurrentNode[K, V]
+	found := s.find(key, &preds, &succs)
+	if found != -1 && live(succs[found]) {
+		return *succs[found].value.Load(), true
+	}
+	var zero V
+	return zero, false
+}
+
+// Floor finds the largest key less than or equal to key, without locking
+//
+// Returns that key, its value and true, or zero values and false if every key is bigger.
+//
+// Time complexity: O(log n) on average, plus retries if the key it lands on is being deleted
+func (s *ConcurrentSkipList[K, V]) Floor(key K) (K, V, bool) {
+	var preds, succs [MaxLevel]*concurrentNode[K, V]
+	for {
+		found := s.find(key, &preds, &succs)
+		if found != -1 && live(succs[found]) {
+			return succs[found].key, *succs[found].value.Load(), true
+		}
+
+		pred := preds[0]
+		if pred == s.head {
+			var zeroKey K
+			var zeroValue V
+			return zeroKey, zeroValue, false
+		}
+		if live(pred) {
+			return pred.key, *pred.value.Load(), true
+		}
+		// pred is being inserted or deleted, so look again once that's done
+		runtime.Gosched()
+	}
+}
+
+// Ceiling finds the smallest key greater than or equal to key, without locking
+//
+// Returns that key, its value and true, or zero values and false if every key is smaller.
+//
+// Time complexity: O(log n) on average, because it's the same walk as Search
+func (s *ConcurrentSkipList[K, V]) Ceiling(key K) (K, V, bool) {
+	var preds, succs [MaxLevel]*concurrentNode[K, V]
+	s.find(key, &preds, &succs)
+	for current := succs[0]; current != nil; current = current.next[0].Load() {
+		if live(current) {
+			return current.key, *current.value.Load(), true
+		}
+	}
+	var zeroKey K
+	var zeroValue V
+	return zeroKey, zeroValue, false
+}
+
+// Range calls fn with every key from "from" up to but not including "to", in order, without locking.
+// Stops early if fn returns false.
+//
+// Time complexity: O(log n + m), where m is the number of keys in the range, because we search for "from" then walk the bottom layer
+func (s *ConcurrentSkipList[K, V]) Range(from, to K, fn func(key K, value V) bool) {
+	var preds, succs [MaxLevel]*concurrentNode[K, V]
+	s.find(from, &preds, &succs)
+	for current := succs[0]; current != nil && current.key < to; current = current.next[0].Load() {
+		if live(current) && !fn(current.key, *current.value.Load()) {
+			return
+		}
+	}
+}
+
+// Each calls fn with every key and value, from smallest to largest key, without locking.
+// Stops early if fn returns false.
+//
+// Time complexity: O(n) because it walks the whole bottom layer
+func (s *ConcurrentSkipList[K, V]) Each(fn func(key K, value V) bool) {
+	for current := s.head.next[0].Load(); current != nil; current = current.next[0].Load() {
+		if live(current) && !fn(current.key, *current.value.Load()) {
+			return
+		}
+	}
+}
+
+// find walks down from the top level, filling preds with the last node before key on each level and succs with the node after it
+//
+// Returns the highest level the key was found on, or -1 if it wasn't found.
+func (s *ConcurrentSkipList[K, V]) find(key K, preds, succs *[MaxLevel]*concurrentNode[K, V]) int {
+	found := -1
+	pred := s.head
+	for i := MaxLevel - 1; i >= 0; i-- {
+		current := pred.next[i].Load()
+		for current != nil && current.key < key {
+			pred = current
+			current = pred.next[i].Load()
+		}
+		if found == -1 && current != nil && current.key == key {
+			found = i
+		}
+		preds[i] = pred
+		succs[i] = current
+	}
+	return found
+}
+
+// randomLevel picks how many levels a new node gets, taking the rng lock
+func (s *ConcurrentSkipList[K, V]) randomLevel() int {
+	s.rngMu.Lock()
+	defer s.rngMu.Unlock()
+	return randomLevel(s.rng)
+}
+
+// unlockPreds unlocks each distinct pred from level 0 up to highestLocked
+func unlockPreds[K cmp.Ordered, V any](preds *[MaxLevel]*concurrentNode[K, V], highestLocked int) {
+	for i := 0; i <= highestLocked; i++ {
+		if i == 0 || preds[i] != preds[i-1] {
+			preds[i].mu.Unlock()
+		}
+	}
+}
+
+// live returns true if a node is fully inserted and not being deleted
+func live[K cmp.Ordered, V any](n *concurrentNode[K, V]) bool {
+	return n.fullyLinked.Load() && !n.marked.Load()
+}
+
+// canDelete returns true if a node is fully inserted, not already being deleted, and was found on its top level,
+// which means find filled in preds for every level it's on
+func canDelete[K cmp.Ordered, V any](n *concurrentNode[K, V], found int) bool {
+	return live(n) && len(n.next) == found+1
+}
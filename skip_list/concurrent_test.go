@@ -0,0 +1,95 @@
+package skip_list
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/robertjshirts/data-structures/util"
+)
+
+func TestConcurrentSkipList_ParallelInserts(t *testing.T) {
+	// Arrange
+	list := NewConcurrentSkipList[int, int](1)
+	var wg sync.WaitGroup
+	// Act
+	for worker := 0; worker < 8; worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for key := worker; key < 800; key += 8 {
+				list.Insert(key, key)
+			}
+		}(worker)
+	}
+	wg.Wait()
+	// Assert
+	util.SimpleAssert(t, list.Len(), 800)
+	var keys []int
+	list.Each(func(key int, value int) bool {
+		util.SimpleAssert(t, key, value)
+		keys = append(keys, key)
+		return true
+	})
+	util.SimpleAssert(t, len(keys), 800)
+	util.SimpleAssert(t, slices.IsSorted(keys), true)
+}
+
+func TestConcurrentSkipList_ParallelInsertsAndDeletes(t *testing.T) {
+	// Arrange
+	list := NewConcurrentSkipList[int, int](1)
+	for key := 0; key < 1000; key++ {
+		list.Insert(key, key)
+	}
+	var wg sync.WaitGroup
+	// Act
+	// Half the workers delete the odd keys while the others keep overwriting and reading the even ones
+	for worker := 0; worker < 8; worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for key := worker / 2 * 2; key < 1000; key += 8 {
+				if worker%2 == 0 {
+					list.Insert(key, key)
+					list.Search(key)
+					list.Floor(key + 1)
+					list.Ceiling(key + 1)
+				} else {
+					list.Delete(key + 1)
+				}
+			}
+		}(worker)
+	}
+	wg.Wait()
+	// Assert
+	util.SimpleAssert(t, list.Len(), 500)
+	list.Each(func(key int, _ int) bool {
+		util.SimpleAssert(t, key%2, 0)
+		return true
+	})
+}
+
+func TestConcurrentSkipList_RacingDeletesOnlyOneWins(t *testing.T) {
+	// Arrange
+	list := NewConcurrentSkipList[int, int](1)
+	list.Insert(1, 1)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	wins := 0
+	// Act
+	for worker := 0; worker < 8; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, ok := list.Delete(1); ok {
+				mu.Lock()
+				wins++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	// Assert
+	util.SimpleAssert(t, wins, 1)
+	util.SimpleAssert(t, list.Len(), 0)
+}
@@ -0,0 +1,204 @@
+package skip_list
+
+import (
+	"cmp"
+	"math/rand"
+)
+
+// MaxLevel is the most levels a node can have. With a 1/2 chance of going up a level,
+// 32 levels keeps searches O(log n) for far more keys than fit in memory
+const MaxLevel = 32
+
+type node[K cmp.Ordered, V any] struct {
+	key   K
+	value V
+	next  []*node[K, V]
+}
+
+// SkipList is a sorted map built from layers of linked lists. Every key is on the bottom layer,
+// and each layer up skips over about half the keys of the one below, so searches can jump ahead instead of walking.
+//
+// A SkipList is not safe for concurrent use, see ConcurrentSkipList for that.
+type SkipList[K cmp.Ordered, V any] struct {
+	head  *node[K, V]
+	level int
+	count int
+	rng   *rand.Rand
+}
+
+// NewSkipList creates an empty skip list. The seed picks the level of every inserted key,
+// so the same seed and the same inserts always build the same list
+//
+// Time complexity: O(1) because we're just instantiating the head
+func NewSkipList[K cmp.Ordered, V any](seed int64) *SkipList[K, V] {
+	return &SkipList[K, V]{
+		head:  &node[K, V]{next: make([]*node[K, V], MaxLevel)},
+		level: 1,
+		count: 0,
+		rng:   rand.New(rand.NewSource(seed)),
+	}
+}
+
+// Len returns the number of keys in the list
+//
+// Time complexity: O(1) because we keep a count
+func (s *SkipList[K, V]) Len() int {
+	return s.count
+}
+
+// Insert sets the value for a key, adding the key if it isn't in the list yet
+//
+// Returns true if the key was added, false if it was already there and only the value changed.
+//
+// Time complexity: O(log n) on average, because each level skips about half the keys of the one below
+func (s *SkipList[K, V]) Insert(key K, value V) bool {
+	var preds [MaxLevel]*node[K, V]
+	found := s.find(key, &preds)
+	if found != nil {
+		found.value = value
+		return false
+	}
+
+	level := randomLevel(s.rng)
+	// Any new levels start from the head
+	for i := s.level; i < level; i++ {
+		preds[i] = s.head
+	}
+	s.level = max(s.level, level)
+
+	newNode := &node[K, V]{key: key, value: value, next: make([]*node[K, V], level)}
+	for i := 0; i < level; i++ {
+		newNode.next[i] = preds[i].next[i]
+		preds[i].next[i] = newNode
+	}
+	s.count++
+	return true
+}
+
+// Delete removes a key from the list
+//
+// Returns the key's value and true, or the zero value and false if the key wasn't there.
+//
+// Time complexity: O(log n) on average, because finding the key is O(log n) and unlinking it is O(levels)
+func (s *SkipList[K, V]) Delete(key K) (V, bool) {
+	var preds [MaxLevel]*node[K, V]
+	found := s.find(key, &preds)
+	if found == nil {
+		var zero V
+		return zero, false
+	}
+
+	for i := range found.next {
+		preds[i].next[i] = found.next[i]
+	}
+	// Drop levels that only had this key
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+	s.count--
+	return found.value, true
+}
+
+// Search finds the value for a key
+//
+// Returns the value and true, or the zero value and false if the key isn't in the list.
+//
+// Time complexity: O(log n) on average, because each level skips about half the keys of the one below
+func (s *SkipList[K, V]) Search(key K) (V, bool) {
+	var preds [MaxLevel]*node[K, V]
+	if found := s.find(key, &preds); found != nil {
+		return found.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Floor finds the largest key less than or equal to key
+//
+// Returns that key, its value and true, or zero values and false if every key is bigger.
+//
+// Time complexity: O(log n) on average, because it's the same walk as Search
+func (s *SkipList[K, V]) Floor(key K) (K, V, bool) {
+	var preds [MaxLevel]*node[K, V]
+	if found := s.find(key, &preds); found != nil {
+		return found.key, found.value, true
+	}
+	// preds[0] is the last key smaller than key, unless it's the head
+	return entry(preds[0], s.head)
+}
+
+// Ceiling finds the smallest key greater than or equal to key
+//
+// Returns that key, its value and true, or zero values and false if every key is smaller.
+//
+// Time complexity: O(log n) on average, because it's the same walk as Search
+func (s *SkipList[K, V]) Ceiling(key K) (K, V, bool) {
+	var preds [MaxLevel]*node[K, V]
+	if found := s.find(key, &preds); found != nil {
+		return found.key, found.value, true
+	}
+	return entry(preds[0].next[0], s.head)
+}
+
+// Range calls fn with every key from "from" up to but not including "to", in order.
+// Stops early if fn returns false.
+//
+// Time complexity: O(log n + m), where m is the number of keys in the range, because we search for "from" then walk the bottom layer
+func (s *SkipList[K, V]) Range(from, to K, fn func(key K, value V) bool) {
+	var preds [MaxLevel]*node[K, V]
+	s.find(from, &preds)
+	for current := preds[0].next[0]; current != nil && current.key < to; current = current.next[0] {
+		if !fn(current.key, current.value) {
+			return
+		}
+	}
+}
+
+// Each calls fn with every key and value, from smallest to largest key.
+// Stops early if fn returns false.
+//
+// Time complexity: O(n) because it walks the whole bottom layer
+func (s *SkipList[K, V]) Each(fn func(key K, value V) bool) {
+	for current := s.head.next[0]; current != nil; current = current.next[0] {
+		if !fn(current.key, current.value) {
+			return
+		}
+	}
+}
+
+// find walks down from the top level, filling preds with the last node before key on each level
+//
+// Returns the node with key, or nil if there isn't one.
+func (s *SkipList[K, V]) find(key K, preds *[MaxLevel]*node[K, V]) *node[K, V] {
+	current := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for current.next[i] != nil && current.next[i].key < key {
+			current = current.next[i]
+		}
+		preds[i] = current
+	}
+
+	if next := current.next[0]; next != nil && next.key == key {
+		return next
+	}
+	return nil
+}
+
+// randomLevel picks how many levels a new node gets. Each extra level has half the chance of the one before
+func randomLevel(rng *rand.Rand) int {
+	level := 1
+	for level < MaxLevel && rng.Int63()&1 == 0 {
+		level++
+	}
+	return level
+}
+
+// entry returns a node's key and value, or zero values and false if the node is nil or the head
+func entry[K cmp.Ordered, V any](n, head *node[K, V]) (K, V, bool) {
+	if n == nil || n == head {
+		var zeroKey K
+		var zeroValue V
+		return zeroKey, zeroValue, false
+	}
+	return n.key, n.value, true
+}
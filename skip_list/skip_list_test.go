@@ -0,0 +1,300 @@
+package skip_list
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/robertjshirts/data-structures/avl_tree"
+	"github.com/robertjshirts/data-structures/util"
+)
+
+// sortedMap is what both skip lists have in common, so the same tests can run on each
+type sortedMap interface {
+	Insert(key int, value string) bool
+	Delete(key int) (string, bool)
+	Search(key int) (string, bool)
+	Floor(key int) (int, string, bool)
+	Ceiling(key int) (int, string, bool)
+	Range(from, to int, fn func(key int, value string) bool)
+	Each(fn func(key int, value string) bool)
+	Len() int
+}
+
+var constructors = map[string]func(seed int64) sortedMap{
+	"SkipList":           func(seed int64) sortedMap { return NewSkipList[int, string](seed) },
+	"ConcurrentSkipList": func(seed int64) sortedMap { return NewConcurrentSkipList[int, string](seed) },
+}
+
+func keysOf(s sortedMap) []int {
+	var keys []int
+	s.Each(func(key int, _ string) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+func TestSkipList_InsertAndSearch(t *testing.T) {
+	for name, newList := range constructors {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			list := newList(1)
+			// Act
+			added := list.Insert(5, "five")
+			list.Insert(1, "one")
+			list.Insert(9, "nine")
+			// Assert
+			util.SimpleAssert(t, added, true)
+			util.SimpleAssert(t, list.Len(), 3)
+			value, ok := list.Search(5)
+			util.SimpleAssert(t, value, "five")
+			util.SimpleAssert(t, ok, true)
+			_, ok = list.Search(4)
+			util.SimpleAssert(t, ok, false)
+			util.SimpleAssert(t, slices.Equal(keysOf(list), []int{1, 5, 9}), true)
+		})
+	}
+}
+
+func TestSkipList_InsertReplacesValue(t *testing.T) {
+	for name, newList := range constructors {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			list := newList(1)
+			list.Insert(5, "five")
+			// Act
+			added := list.Insert(5, "FIVE")
+			// Assert
+			util.SimpleAssert(t, added, false)
+			util.SimpleAssert(t, list.Len(), 1)
+			value, _ := list.Search(5)
+			util.SimpleAssert(t, value, "FIVE")
+		})
+	}
+}
+
+func TestSkipList_Delete(t *testing.T) {
+	for name, newList := range constructors {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			list := newList(1)
+			list.Insert(1, "one")
+			list.Insert(2, "two")
+			list.Insert(3, "three")
+			// Act
+			value, ok := list.Delete(2)
+			_, missingOk := list.Delete(2)
+			// Assert
+			util.SimpleAssert(t, value, "two")
+			util.SimpleAssert(t, ok, true)
+			util.SimpleAssert(t, missingOk, false)
+			util.SimpleAssert(t, list.Len(), 2)
+			util.SimpleAssert(t, slices.Equal(keysOf(list), []int{1, 3}), true)
+		})
+	}
+}
+
+func TestSkipList_FloorAndCeiling(t *testing.T) {
+	for name, newList := range constructors {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			list := newList(1)
+			for _, key := range []int{10, 20, 30} {
+				list.Insert(key, "")
+			}
+			// Act & Assert
+			key, _, ok := list.Floor(25)
+			util.SimpleAssert(t, key, 20)
+			util.SimpleAssert(t, ok, true)
+			key, _, _ = list.Floor(20)
+			util.SimpleAssert(t, key, 20)
+			_, _, ok = list.Floor(5)
+			util.SimpleAssert(t, ok, false)
+
+			key, _, ok = list.Ceiling(25)
+			util.SimpleAssert(t, key, 30)
+			util.SimpleAssert(t, ok, true)
+			key, _, _ = list.Ceiling(10)
+			util.SimpleAssert(t, key, 10)
+			_, _, ok = list.Ceiling(35)
+			util.SimpleAssert(t, ok, false)
+		})
+	}
+}
+
+func TestSkipList_Range(t *testing.T) {
+	for name, newList := range constructors {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			list := newList(1)
+			for key := 0; key < 10; key++ {
+				list.Insert(key, "")
+			}
+			var inRange, stopped []int
+			// Act
+			list.Range(3, 7, func(key int, _ string) bool {
+				inRange = append(inRange, key)
+				return true
+			})
+			list.Range(0, 10, func(key int, _ string) bool {
+				stopped = append(stopped, key)
+				return key < 1
+			})
+			// Assert
+			util.SimpleAssert(t, slices.Equal(inRange, []int{3, 4, 5, 6}), true)
+			util.SimpleAssert(t, slices.Equal(stopped, []int{0, 1}), true)
+		})
+	}
+}
+
+func TestSkipList_MatchesMap(t *testing.T) {
+	for name, newList := range constructors {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			list := newList(40)
+			expected := map[int]string{}
+			rng := rand.New(rand.NewSource(40))
+			// Act
+			for i := 0; i < 2000; i++ {
+				key := rng.Intn(200)
+				if rng.Intn(3) == 0 {
+					_, wasThere := expected[key]
+					_, ok := list.Delete(key)
+					delete(expected, key)
+					util.SimpleAssert(t, ok, wasThere)
+				} else {
+					expected[key] = name
+					list.Insert(key, name)
+				}
+			}
+			// Assert
+			var keys []int
+			for key := range expected {
+				keys = append(keys, key)
+			}
+			slices.Sort(keys)
+			util.SimpleAssert(t, list.Len(), len(expected))
+			util.SimpleAssert(t, slices.Equal(keysOf(list), keys), true)
+		})
+	}
+}
+
+func TestSkipList_SameSeedBuildsSameLevels(t *testing.T) {
+	// Arrange
+	a := NewSkipList[int, int](7)
+	b := NewSkipList[int, int](7)
+	// Act
+	for key := 0; key < 100; key++ {
+		a.Insert(key, key)
+		b.Insert(key, key)
+	}
+	// Assert
+	util.SimpleAssert(t, a.level, b.level)
+	for current, other := a.head.next[0], b.head.next[0]; current != nil; current, other = current.next[0], other.next[0] {
+		util.SimpleAssert(t, len(current.next), len(other.next))
+	}
+}
+
+func TestSkipList_DeleteDropsEmptyLevels(t *testing.T) {
+	// Arrange
+	list := NewSkipList[int, int](3)
+	for key := 0; key < 100; key++ {
+		list.Insert(key, key)
+	}
+	// Act
+	for key := 0; key < 100; key++ {
+		list.Delete(key)
+	}
+	// Assert
+	util.SimpleAssert(t, list.Len(), 0)
+	util.SimpleAssert(t, list.level, 1)
+}
+
+const benchmarkKeys = 10000
+
+func BenchmarkSkipList_Insert(b *testing.B) {
+	keys := rand.New(rand.NewSource(1)).Perm(benchmarkKeys)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		list := NewSkipList[int, int](1)
+		for _, key := range keys {
+			list.Insert(key, key)
+		}
+	}
+}
+
+func BenchmarkConcurrentSkipList_Insert(b *testing.B) {
+	keys := rand.New(rand.NewSource(1)).Perm(benchmarkKeys)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		list := NewConcurrentSkipList[int, int](1)
+		for _, key := range keys {
+			list.Insert(key, key)
+		}
+	}
+}
+
+func BenchmarkAVLTree_Insert(b *testing.B) {
+	keys := rand.New(rand.NewSource(1)).Perm(benchmarkKeys)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tree := avl_tree.EmptyAVLTree[int]()
+		for _, key := range keys {
+			tree.Insert(key)
+		}
+	}
+}
+
+func BenchmarkSkipList_Search(b *testing.B) {
+	list := NewSkipList[int, int](1)
+	for _, key := range rand.New(rand.NewSource(1)).Perm(benchmarkKeys) {
+		list.Insert(key, key)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		list.Search(i % benchmarkKeys)
+	}
+}
+
+func BenchmarkConcurrentSkipList_Search(b *testing.B) {
+	list := NewConcurrentSkipList[int, int](1)
+	for _, key := range rand.New(rand.NewSource(1)).Perm(benchmarkKeys) {
+		list.Insert(key, key)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		list.Search(i % benchmarkKeys)
+	}
+}
+
+func BenchmarkAVLTree_Contains(b *testing.B) {
+	tree := avl_tree.NewAVLTree(rand.New(rand.NewSource(1)).Perm(benchmarkKeys)...)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tree.Contains(i % benchmarkKeys)
+	}
+}
+
+func BenchmarkConcurrentSkipList_ParallelMixed(b *testing.B) {
+	list := NewConcurrentSkipList[int, int](1)
+	for _, key := range rand.New(rand.NewSource(1)).Perm(benchmarkKeys) {
+		list.Insert(key, key)
+	}
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			key := i % benchmarkKeys
+			switch i % 10 {
+			case 0:
+				list.Insert(key, i)
+			case 1:
+				list.Delete(key)
+			default:
+				list.Search(key)
+			}
+			i++
+		}
+	})
+}
@@ -0,0 +1,28 @@
+package stack
+
+// Pop removes the top value from the stack and returns it as a pointer
+//
+// # Returns the top value of the stack, as a pointer. Returns nil if the stack is empty
+//
+// Deprecated: Use TryPop, which returns (value, ok) and doesn't make the value escape to the heap.
+func (s *Stack[T]) Pop() *T {
+	value, ok := s.TryPop()
+	if !ok {
+		return nil
+	}
+	return &value
+}
+
+// Peek returns the top value of the stack, as a pointer.
+// The pointer is to a copy, so changing the value through it doesn't change the stack.
+//
+// # Returns the top value of the stack, as a pointer. Returns nil if the stack is empty
+//
+// Deprecated: Use TryPeek, which returns (value, ok).
+func (s *Stack[T]) Peek() *T {
+	value, ok := s.TryPeek()
+	if !ok {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,20 @@
+package stack
+
+import "testing"
+
+func TestStack_DeprecatedPointerForms(t *testing.T) {
+	// Arrange
+	stack := NewStack[int]()
+	stack.Push(1)
+	stack.Push(2)
+	// Act
+	peeked := stack.Peek()
+	*peeked = 100
+	popped := stack.Pop()
+	// Assert
+	// Peek hands out a copy, so writing through it doesn't change the stack
+	simpleAssert(t, *popped, 2)
+	stack.Pop()
+	nilAssert(t, stack.Pop())
+	nilAssert(t, stack.Peek())
+}
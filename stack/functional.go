@@ -0,0 +1,64 @@
+package stack
+
+import "slices"
+
+// Each calls fn with the index and value of each item, from the top of the stack down, using the same indexes as Get.
+// Stops early if fn returns false.
+//
+// This makes the stack a linked_list.Iterable, so linked_list.Reduce, FindFunc, IndexFunc and ContainsFunc work on it.
+//
+// Time complexity: O(n), because it visits every value once
+func (s *Stack[T]) Each(fn func(index int, value T) bool) {
+	for i := len(s.items) - 1; i >= 0; i-- {
+		if !fn(len(s.items)-1-i, s.items[i]) {
+			return
+		}
+	}
+}
+
+// Map creates a new stack with fn applied to every value, keeping the same order from top to bottom.
+// fn is called from the top of the stack down, the same as Each.
+//
+// Time complexity: O(n), because it visits every value once
+func Map[T, U any](s *Stack[T], fn func(T) U) *Stack[U] {
+	items := make([]U, len(s.items))
+	for i := len(s.items) - 1; i >= 0; i-- {
+		items[i] = fn(s.items[i])
+	}
+	return &Stack[U]{
+		items: items,
+	}
+}
+
+// Filter creates a new stack with only the values the predicate returns true for, keeping the same order from top to bottom.
+// predicate is called from the top of the stack down, the same as Each.
+//
+// Time complexity: O(n), because it visits every value once
+func Filter[T any](s *Stack[T], predicate func(T) bool) *Stack[T] {
+	var items []T
+	for i := len(s.items) - 1; i >= 0; i-- {
+		if predicate(s.items[i]) {
+			items = append(items, s.items[i])
+		}
+	}
+	slices.Reverse(items)
+	return &Stack[T]{
+		items: items,
+	}
+}
+
+// RemoveIf removes every value the predicate returns true for, in place.
+// Unlike Each, Map and Filter, predicate is called from the bottom of the stack up, because that's the order values move in.
+//
+// # Returns the number of values removed
+//
+// Time complexity: O(n), because every kept value moves at most once
+func RemoveIf[T any](s *Stack[T], predicate func(T) bool) int {
+	kept := slices.DeleteFunc(s.items, predicate)
+	// Clear the freed slots at the end, so the garbage collector can free whatever they pointed to
+	clear(s.items[len(kept):])
+	removed := len(s.items) - len(kept)
+	s.items = kept
+	s.shrink()
+	return removed
+}
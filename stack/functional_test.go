@@ -0,0 +1,123 @@
+package stack
+
+import (
+	"github.com/robertjshirts/data-structures/linked_list"
+	"strconv"
+	"testing"
+)
+
+func TestStack_EachVisitsFromTop(t *testing.T) {
+	// Arrange
+	stack := NewStack[int]()
+	stack.Push(0)
+	stack.Push(1)
+	var values []int
+	// Act
+	stack.Each(func(index int, value int) bool {
+		values = append(values, value)
+		return true
+	})
+	// Assert
+	simpleAssert(t, len(values), 2)
+	simpleAssert(t, values[0], 1)
+}
+
+func TestStack_WorksWithIterableHelpers(t *testing.T) {
+	// Arrange
+	stack := NewStack[int]()
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	// Act
+	sum := linked_list.Reduce[int](stack, 0, func(total, value int) int { return total + value })
+	index := linked_list.IndexFunc[int](stack, func(value int) bool { return value == 1 })
+	// Assert
+	simpleAssert(t, sum, 6)
+	simpleAssert(t, index, 2)
+}
+
+func TestMap_KeepsOrder(t *testing.T) {
+	// Arrange
+	stack := NewStack[int]()
+	stack.Push(1)
+	stack.Push(2)
+	// Act
+	got := Map(stack, strconv.Itoa)
+	// Assert
+	simpleAssert(t, *got.Pop(), "2")
+	simpleAssert(t, *got.Pop(), "1")
+	nilAssert(t, got.Pop())
+}
+
+func TestMap_VisitsFromTop(t *testing.T) {
+	// Arrange
+	stack := NewStack[int]()
+	stack.PushAll(1, 2, 3)
+	var visited []int
+	// Act
+	Map(stack, func(value int) int {
+		visited = append(visited, value)
+		return value
+	})
+	// Assert
+	simpleAssert(t, len(visited), 3)
+	simpleAssert(t, visited[0], 3)
+	simpleAssert(t, visited[2], 1)
+}
+
+func TestFilter_VisitsFromTop(t *testing.T) {
+	// Arrange
+	stack := NewStack[int]()
+	stack.PushAll(1, 2, 3)
+	var visited []int
+	// Act
+	Filter(stack, func(value int) bool {
+		visited = append(visited, value)
+		return true
+	})
+	// Assert
+	simpleAssert(t, len(visited), 3)
+	simpleAssert(t, visited[0], 3)
+	simpleAssert(t, visited[2], 1)
+}
+
+func TestRemoveIf_ShrinksAllTheWay(t *testing.T) {
+	// Arrange
+	stack := NewStack[int]()
+	for i := 0; i < 1024; i++ {
+		stack.Push(i)
+	}
+	// Act
+	RemoveIf(stack, func(value int) bool { return value >= 2 })
+	// Assert
+	simpleAssert(t, cap(stack.items) < 2*minCapacity, true)
+	simpleAssert(t, stack.String(), "[1 0]")
+}
+
+func TestFilter_KeepsMatches(t *testing.T) {
+	// Arrange
+	stack := NewStack[int]()
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(4)
+	// Act
+	got := Filter(stack, func(value int) bool { return value%2 == 0 })
+	// Assert
+	simpleAssert(t, *got.Pop(), 4)
+	simpleAssert(t, *got.Pop(), 2)
+	nilAssert(t, got.Pop())
+}
+
+func TestRemoveIf_RemovesMatches(t *testing.T) {
+	// Arrange
+	stack := NewStack[int]()
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	// Act
+	removed := RemoveIf(stack, func(value int) bool { return value != 2 })
+	// Assert
+	simpleAssert(t, removed, 2)
+	simpleAssert(t, *stack.Pop(), 2)
+	nilAssert(t, stack.Pop())
+}
@@ -0,0 +1,84 @@
+package stack
+
+import "sync/atomic"
+
+type lockFreeNode[T any] struct {
+	value T
+	next  *lockFreeNode[T]
+}
+
+// LockFreeStack is a Treiber stack: a linked list whose head is swapped with compare-and-swap,
+// so any number of goroutines can push and pop at once without locks.
+// It has the same Push, TryPop and TryPeek as Stack. The garbage collector keeps a node alive while any goroutine can see it,
+// which is what rules out the ABA problem this design has in languages without one.
+type LockFreeStack[T any] struct {
+	head  atomic.Pointer[lockFreeNode[T]]
+	count atomic.Int64
+}
+
+func NewLockFreeStack[T any]() *LockFreeStack[T] {
+	return &LockFreeStack[T]{}
+}
+
+// Push adds a value to the top of the stack
+//
+// Time complexity: O(1), plus a retry each time another goroutine changes the top first
+func (s *LockFreeStack[T]) Push(value T) {
+	newNode := &lockFreeNode[T]{value: value}
+	for {
+		top := s.head.Load()
+		newNode.next = top
+		if s.head.CompareAndSwap(top, newNode) {
+			s.count.Add(1)
+			return
+		}
+	}
+}
+
+// TryPop removes the top value from the stack and returns it
+//
+// # Returns the top value of the stack and true, or the zero value and false if the stack is empty
+//
+// Time complexity: O(1), plus a retry each time another goroutine changes the top first
+func (s *LockFreeStack[T]) TryPop() (T, bool) {
+	for {
+		top := s.head.Load()
+		if top == nil {
+			var zero T
+			return zero, false
+		}
+		if s.head.CompareAndSwap(top, top.next) {
+			s.count.Add(-1)
+			return top.value, true
+		}
+	}
+}
+
+// TryPeek returns the top value of the stack, without removing it. Another goroutine may pop it right after
+//
+// # Returns the top value of the stack and true, or the zero value and false if the stack is empty
+//
+// Time complexity: O(1)
+func (s *LockFreeStack[T]) TryPeek() (T, bool) {
+	top := s.head.Load()
+	if top == nil {
+		var zero T
+		return zero, false
+	}
+	return top.value, true
+}
+
+// Len returns the number of values in the stack. The count is updated just after each push or pop,
+// so it can be briefly off while other goroutines are busy, but it never goes below 0
+//
+// Time complexity: O(1)
+func (s *LockFreeStack[T]) Len() int {
+	return max(0, int(s.count.Load()))
+}
+
+// IsEmpty returns true if there are no values in the stack
+//
+// Time complexity: O(1)
+func (s *LockFreeStack[T]) IsEmpty() bool {
+	return s.head.Load() == nil
+}
@@ -0,0 +1,103 @@
+package stack
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestLockFreeStack_PushPop(t *testing.T) {
+	// Arrange
+	stack := NewLockFreeStack[int]()
+	// Act
+	stack.Push(1)
+	stack.Push(2)
+	// Assert
+	top, ok := stack.TryPeek()
+	okAssert(t, top, ok, 2)
+	simpleAssert(t, stack.Len(), 2)
+	got, ok := stack.TryPop()
+	okAssert(t, got, ok, 2)
+	got, ok = stack.TryPop()
+	okAssert(t, got, ok, 1)
+	_, ok = stack.TryPop()
+	simpleAssert(t, ok, false)
+	_, ok = stack.TryPeek()
+	simpleAssert(t, ok, false)
+	simpleAssert(t, stack.IsEmpty(), true)
+}
+
+func TestLockFreeStack_ConcurrentPushPop(t *testing.T) {
+	// Arrange
+	stack := NewLockFreeStack[int]()
+	const workers, perWorker = 16, 1000
+	var wg sync.WaitGroup
+	popped := make([][]int, workers)
+	// Act
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				stack.Push(w*perWorker + i)
+				if value, ok := stack.TryPop(); ok {
+					popped[w] = append(popped[w], value)
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	for value, ok := stack.TryPop(); ok; value, ok = stack.TryPop() {
+		popped[0] = append(popped[0], value)
+	}
+	// Assert
+	// Every pushed value should come out exactly once
+	seen := make([]bool, workers*perWorker)
+	count := 0
+	for _, values := range popped {
+		for _, value := range values {
+			simpleAssert(t, seen[value], false)
+			seen[value] = true
+			count++
+		}
+	}
+	simpleAssert(t, count, workers*perWorker)
+	simpleAssert(t, stack.Len(), 0)
+}
+
+// mutexStack is the lock-based stack the lock-free one is benchmarked against
+type mutexStack[T any] struct {
+	mu    sync.Mutex
+	stack Stack[T]
+}
+
+func (s *mutexStack[T]) Push(value T) {
+	s.mu.Lock()
+	s.stack.Push(value)
+	s.mu.Unlock()
+}
+
+func (s *mutexStack[T]) TryPop() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.TryPop()
+}
+
+func BenchmarkLockFreeStack_Parallel(b *testing.B) {
+	stack := NewLockFreeStack[int]()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			stack.Push(i)
+			stack.TryPop()
+		}
+	})
+}
+
+func BenchmarkMutexStack_Parallel(b *testing.B) {
+	stack := &mutexStack[int]{}
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			stack.Push(i)
+			stack.TryPop()
+		}
+	})
+}
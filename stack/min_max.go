@@ -0,0 +1,136 @@
+package stack
+
+// MinStack is a stack that can also tell you its smallest value in O(1).
+// Alongside the values, it keeps a second stack of minimums, where each entry is the smallest value at or below it.
+type MinStack[T any] struct {
+	values  Stack[T]
+	minimum Stack[T]
+	cmp     func(a, b T) int
+}
+
+// NewMinStack creates an empty MinStack. cmp returns a negative number if a is smaller than b,
+// a positive number if a is bigger, and 0 if they're equal, like cmp.Compare
+func NewMinStack[T any](cmp func(a, b T) int) *MinStack[T] {
+	return &MinStack[T]{cmp: cmp}
+}
+
+// Push adds a value to the top of the stack
+//
+// Time complexity: O(1) amortized, because it pushes onto at most two slice-backed stacks
+func (s *MinStack[T]) Push(value T) {
+	s.values.Push(value)
+	// Push ties too, so popping one copy of the minimum leaves the other
+	if current, ok := s.minimum.TryPeek(); !ok || s.cmp(value, current) <= 0 {
+		s.minimum.Push(value)
+	}
+}
+
+// TryPop removes the top value from the stack and returns it
+//
+// # Returns the top value of the stack and true, or the zero value and false if the stack is empty
+//
+// Time complexity: O(1) amortized, because it pops from at most two slice-backed stacks
+func (s *MinStack[T]) TryPop() (T, bool) {
+	value, ok := s.values.TryPop()
+	if !ok {
+		return value, false
+	}
+	if current, _ := s.minimum.TryPeek(); s.cmp(value, current) == 0 {
+		s.minimum.TryPop()
+	}
+	return value, true
+}
+
+// TryPeek returns the top value of the stack, without removing it
+//
+// # Returns the top value of the stack and true, or the zero value and false if the stack is empty
+//
+// Time complexity: O(1)
+func (s *MinStack[T]) TryPeek() (T, bool) {
+	return s.values.TryPeek()
+}
+
+// Min returns the smallest value in the stack, according to cmp
+//
+// # Returns the smallest value and true, or the zero value and false if the stack is empty
+//
+// Time complexity: O(1), because it's the top of the stack of minimums
+func (s *MinStack[T]) Min() (T, bool) {
+	return s.minimum.TryPeek()
+}
+
+// Len returns the number of values in the stack
+//
+// Time complexity: O(1)
+func (s *MinStack[T]) Len() int {
+	return s.values.Len()
+}
+
+// IsEmpty returns true if there are no values in the stack
+//
+// Time complexity: O(1)
+func (s *MinStack[T]) IsEmpty() bool {
+	return s.values.IsEmpty()
+}
+
+// MaxStack is a stack that can also tell you its biggest value in O(1).
+// It's a MinStack with the comparator flipped around.
+type MaxStack[T any] struct {
+	stack MinStack[T]
+}
+
+// NewMaxStack creates an empty MaxStack. cmp returns a negative number if a is smaller than b,
+// a positive number if a is bigger, and 0 if they're equal, like cmp.Compare
+func NewMaxStack[T any](cmp func(a, b T) int) *MaxStack[T] {
+	return &MaxStack[T]{
+		stack: MinStack[T]{cmp: func(a, b T) int { return cmp(b, a) }},
+	}
+}
+
+// Push adds a value to the top of the stack
+//
+// Time complexity: O(1) amortized, because MinStack.Push is O(1) amortized
+func (s *MaxStack[T]) Push(value T) {
+	s.stack.Push(value)
+}
+
+// TryPop removes the top value from the stack and returns it
+//
+// # Returns the top value of the stack and true, or the zero value and false if the stack is empty
+//
+// Time complexity: O(1) amortized, because MinStack.TryPop is O(1) amortized
+func (s *MaxStack[T]) TryPop() (T, bool) {
+	return s.stack.TryPop()
+}
+
+// TryPeek returns the top value of the stack, without removing it
+//
+// # Returns the top value of the stack and true, or the zero value and false if the stack is empty
+//
+// Time complexity: O(1)
+func (s *MaxStack[T]) TryPeek() (T, bool) {
+	return s.stack.TryPeek()
+}
+
+// Max returns the biggest value in the stack, according to cmp
+//
+// # Returns the biggest value and true, or the zero value and false if the stack is empty
+//
+// Time complexity: O(1), because MinStack.Min is O(1)
+func (s *MaxStack[T]) Max() (T, bool) {
+	return s.stack.Min()
+}
+
+// Len returns the number of values in the stack
+//
+// Time complexity: O(1)
+func (s *MaxStack[T]) Len() int {
+	return s.stack.Len()
+}
+
+// IsEmpty returns true if there are no values in the stack
+//
+// Time complexity: O(1)
+func (s *MaxStack[T]) IsEmpty() bool {
+	return s.stack.IsEmpty()
+}
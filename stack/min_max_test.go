@@ -0,0 +1,98 @@
+package stack
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestMinStack_TracksMinimum(t *testing.T) {
+	// Arrange
+	stack := NewMinStack(cmp.Compare[int])
+	_, emptyOk := stack.Min()
+	// Act
+	stack.Push(5)
+	stack.Push(3)
+	stack.Push(7)
+	stack.Push(3)
+	// Assert
+	simpleAssert(t, emptyOk, false)
+	got, ok := stack.Min()
+	okAssert(t, got, ok, 3)
+	stack.TryPop()
+	stack.TryPop()
+	got, _ = stack.Min()
+	simpleAssert(t, got, 3)
+	stack.TryPop()
+	got, _ = stack.Min()
+	simpleAssert(t, got, 5)
+	simpleAssert(t, stack.Len(), 1)
+}
+
+func TestMinStack_TryPopOnEmptyStack(t *testing.T) {
+	// Arrange
+	stack := NewMinStack(cmp.Compare[int])
+	// Act
+	_, ok := stack.TryPop()
+	// Assert
+	simpleAssert(t, ok, false)
+	simpleAssert(t, stack.IsEmpty(), true)
+}
+
+func TestMinStack_CustomComparator(t *testing.T) {
+	// Arrange
+	byLength := func(a, b string) int { return cmp.Compare(len(a), len(b)) }
+	stack := NewMinStack(byLength)
+	// Act
+	stack.Push("three")
+	stack.Push("to")
+	stack.Push("four")
+	// Assert
+	got, _ := stack.Min()
+	simpleAssert(t, got, "to")
+	top, _ := stack.TryPeek()
+	simpleAssert(t, top, "four")
+}
+
+func TestMaxStack_TracksMaximum(t *testing.T) {
+	// Arrange
+	stack := NewMaxStack(cmp.Compare[int])
+	// Act
+	stack.Push(1)
+	stack.Push(9)
+	stack.Push(4)
+	// Assert
+	got, ok := stack.Max()
+	okAssert(t, got, ok, 9)
+	stack.TryPop()
+	stack.TryPop()
+	got, _ = stack.Max()
+	simpleAssert(t, got, 1)
+	top, _ := stack.TryPeek()
+	simpleAssert(t, top, 1)
+	simpleAssert(t, stack.Len(), 1)
+	simpleAssert(t, stack.IsEmpty(), false)
+}
+
+func TestMinStack_MatchesSlicesMin(t *testing.T) {
+	// Arrange
+	rng := rand.New(rand.NewSource(47))
+	stack := NewMinStack(cmp.Compare[int])
+	var values []int
+	// Act & Assert
+	for i := 0; i < 1000; i++ {
+		if len(values) > 0 && rng.Intn(3) == 0 {
+			stack.TryPop()
+			values = values[:len(values)-1]
+		} else {
+			value := rng.Intn(20)
+			stack.Push(value)
+			values = append(values, value)
+		}
+		if len(values) > 0 {
+			got, _ := stack.Min()
+			simpleAssert(t, got, slices.Min(values))
+		}
+	}
+}
@@ -1,16 +1,23 @@
 package stack
 
-import "github.com/robertjshirts/data-structures/linked_list"
+import (
+	"fmt"
+	"slices"
+)
 
-type Stack[T comparable] struct {
-	list *linked_list.SingleLinkedList[T]
+// minCapacity is the smallest the stack shrinks to, so small stacks don't reallocate on every few pushes and pops
+const minCapacity = 8
+
+// Stack is a last in, first out stack backed by a slice. The top of the stack is the end of the slice,
+// so pushes and pops don't allocate unless the slice has to grow.
+type Stack[T any] struct {
+	items []T
+	// reserved is the capacity asked for with Reserve, which the stack won't shrink below
+	reserved int
 }
 
-func NewStack[T comparable]() *Stack[T] {
-	list := linked_list.EmptySingleLinkedList[T]()
-	return &Stack[T]{
-		list: &list,
-	}
+func NewStack[T any]() *Stack[T] {
+	return &Stack[T]{}
 }
 
 // Push adds a value to the top of the stack
@@ -18,74 +25,185 @@ func NewStack[T comparable]() *Stack[T] {
 // # Arguments
 // value: The value to add to the stack
 //
-// Time complexity: O(1)
-// Because list.Push is O(1), the time complexity is O(1)
+// Time complexity: O(1) amortized
+// Because append only has to grow the slice when it's full, and it grows by a multiple when it does
 func (s *Stack[T]) Push(value T) {
-	s.list.Push(value)
+	s.items = append(s.items, value)
 }
 
-// Pop removes the top value from the stack and returns it as a pointer
+// PushAll adds values to the top of the stack in order, so the last value ends up on top
 //
-// # Returns the top value of the stack, as a pointer. Returns nil if the stack is empty
+// Time complexity: O(k) amortized, where k is the number of values, because the slice grows at most once
+func (s *Stack[T]) PushAll(values ...T) {
+	s.items = append(s.items, values...)
+}
+
+// TryPop removes the top value from the stack and returns it
 //
-// Time complexity: O(1)
-// Because we're just removing the first value in the list, the time complexity is O(1)
-// Also s.list.Remove() is O(1) because we're just removing the first value in the list
-func (s *Stack[T]) Pop() *T {
-	if s.list.Head == nil {
-		return nil
+// # Returns the top value of the stack and true, or the zero value and false if the stack is empty
+//
+// Time complexity: O(1) amortized
+// Because we're just removing the last value in the slice, and it only shrinks once it's a quarter full
+func (s *Stack[T]) TryPop() (T, bool) {
+	var zero T
+	if len(s.items) == 0 {
+		return zero, false
 	}
 
-	value := s.list.Remove()
-	return &value
+	last := len(s.items) - 1
+	value := s.items[last]
+	// Clear the slot so the garbage collector can free whatever it pointed to
+	s.items[last] = zero
+	s.items = s.items[:last]
+	s.shrink()
+	return value, true
 }
 
-// Peek returns the top value of the stack, as a pointer
+// TryPeek returns a copy of the top value of the stack, without removing it
 //
-// # Returns the top value of the stack, as a pointer. Returns nil if the stack is empty
+// # Returns the top value of the stack and true, or the zero value and false if the stack is empty
 //
 // Time complexity: O(1)
-// Because we're just getting the first value in the list, the time complexity is O(1)
-func (s *Stack[T]) Peek() *T {
-	if s.list.Head == nil {
-		return nil
+// Because we're just getting the last value in the slice, the time complexity is O(1)
+func (s *Stack[T]) TryPeek() (T, bool) {
+	if len(s.items) == 0 {
+		var zero T
+		return zero, false
 	}
 
-	return &s.list.Head.Value
+	return s.items[len(s.items)-1], true
 }
 
-// Get returns the value at the given index
+// Get returns the value at the given index, where 0 is the top of the stack
 //
-// # Returns the value at the given index, as a pointer. Returns nil if the index is out of bounds
+// # Returns a pointer to a copy of the value at the given index. Returns nil if the index is out of bounds
 //
-// Time complexity: O(n)
-// Because it calls list.Get(), which has a complexity of O(n)
+// Time complexity: O(1)
+// Because the slice can index straight to it
 func (s *Stack[T]) Get(index int) *T {
-	if index < 0 || index >= s.list.Count {
+	if index < 0 || index >= len(s.items) {
 		return nil
 	}
 
-	value := s.list.Get(index)
+	value := s.items[len(s.items)-1-index]
 	return &value
 }
 
-// Contains checks if the stack contains a value
+// Contains checks if the stack contains a value.
+// It's a function instead of a method because only comparable values can be checked with ==
 //
 // # Returns true if the value is in the stack, false otherwise
 //
 // Time complexity: O(n)
+// Because s.ContainsFunc is O(n)
+func Contains[T comparable](s *Stack[T], value T) bool {
+	return s.ContainsFunc(func(other T) bool { return other == value })
+}
+
+// ContainsFunc checks if match returns true for any value in the stack
+//
+// # Returns true if a value matched, false otherwise
+//
+// Time complexity: O(n)
 // Because we have to check every value in the stack, the time complexity is O(n)
+func (s *Stack[T]) ContainsFunc(match func(T) bool) bool {
+	return slices.ContainsFunc(s.items, match)
+}
+
+// PopN removes up to n values from the top of the stack and returns them, top first.
+// Returns fewer than n values if the stack runs out.
 //
-// Pseudo code:
-// if the stack is empty, return false
-// if list.count == 0
+// # Panics if n is negative
 //
-//	return false
+// Time complexity: O(n), because each value is copied once
+func (s *Stack[T]) PopN(n int) []T {
+	if n < 0 {
+		panic("n can't be negative")
+	}
+
+	n = min(n, len(s.items))
+	values := make([]T, n)
+	for i := range values {
+		values[i] = s.items[len(s.items)-1-i]
+	}
+
+	remaining := len(s.items) - n
+	clear(s.items[remaining:])
+	s.items = s.items[:remaining]
+	s.shrink()
+	return values
+}
+
+// Reserve makes sure n more values can be pushed without the slice growing,
+// and keeps the stack from shrinking below that capacity afterwards
+//
+// # Panics if n is negative
+//
+// Time complexity: O(n), because growing the slice copies every value
+func (s *Stack[T]) Reserve(n int) {
+	if n < 0 {
+		panic("n can't be negative")
+	}
+
+	s.items = slices.Grow(s.items, n)
+	s.reserved = max(s.reserved, len(s.items)+n)
+}
+
+// Len returns the number of values in the stack
+//
+// Time complexity: O(1), because it's the length of the slice
+func (s *Stack[T]) Len() int {
+	return len(s.items)
+}
+
+// IsEmpty returns true if there are no values in the stack
 //
-// return s.List.Search(value) != -1
-func (s *Stack[T]) Contains(value T) bool {
-	if s.list.Count == 0 {
-		return false
+// Time complexity: O(1), because it's the length of the slice
+func (s *Stack[T]) IsEmpty() bool {
+	return len(s.items) == 0
+}
+
+// Clear removes every value from the stack, and lets go of the slice unless capacity was reserved
+//
+// Time complexity: O(1) without a reservation, because the garbage collector frees the old slice,
+// and O(n) with one, because the kept slice is zeroed
+func (s *Stack[T]) Clear() {
+	if s.reserved == 0 {
+		s.items = nil
+		return
+	}
+	clear(s.items)
+	s.items = s.items[:0]
+}
+
+// ToSlice copies the values of the stack into a new slice, top first.
+// Changing the slice doesn't change the stack.
+//
+// Time complexity: O(n), because it visits every value once
+func (s *Stack[T]) ToSlice() []T {
+	values := slices.Clone(s.items)
+	slices.Reverse(values)
+	return values
+}
+
+// String formats the stack like a slice, top first, e.g. "[3 2 1]"
+//
+// Time complexity: O(n), because s.ToSlice is O(n)
+func (s *Stack[T]) String() string {
+	return fmt.Sprint(s.ToSlice())
+}
+
+// shrink halves the capacity for as long as the slice is only a quarter full, so a stack that was briefly huge doesn't hold onto the memory.
+// PopN and RemoveIf can empty most of the stack at once, so it works out the final capacity first and reallocates once
+func (s *Stack[T]) shrink() {
+	capacity := cap(s.items)
+	for capacity/2 >= minCapacity && capacity/2 >= s.reserved && len(s.items) <= capacity/4 {
+		capacity /= 2
+	}
+	if capacity == cap(s.items) {
+		return
 	}
-	return s.list.Search(value) != -1
+	items := make([]T, len(s.items), capacity)
+	copy(items, s.items)
+	s.items = items
 }
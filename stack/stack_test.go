@@ -15,7 +15,7 @@ func TestStack_PushPushesValue(t *testing.T) {
 	want := 1
 	// Act
 	stack.Push(want)
-	got := stack.list.Get(0)
+	got := stack.items[len(stack.items)-1]
 	// Assert
 	simpleAssert(t, got, want)
 }
@@ -28,7 +28,7 @@ func TestStack_PushPushesSecondValue(t *testing.T) {
 	stack.Push(0)
 	stack.Push(want)
 	// Get 0 bc it was pushed second, so it's at the top of the stack
-	got := stack.list.Get(0)
+	got := stack.items[len(stack.items)-1]
 	// Assert
 	simpleAssert(t, got, want)
 }
@@ -54,6 +54,27 @@ func TestStack_PeekReturnsNilOnNoValues(t *testing.T) {
 	nilAssert(t, got)
 }
 
+func TestStack_TryPeekReturnsTopValue(t *testing.T) {
+	// Arrange
+	stack := NewStack[int]()
+	want := 1
+	stack.Push(0)
+	stack.Push(want)
+	// Act
+	got, ok := stack.TryPeek()
+	// Assert
+	okAssert(t, got, ok, want)
+}
+
+func TestStack_TryPeekReturnsNotOkOnNoValues(t *testing.T) {
+	// Arrange
+	stack := NewStack[int]()
+	// Act
+	_, ok := stack.TryPeek()
+	// Assert
+	simpleAssert(t, ok, false)
+}
+
 func TestStack_GetReturnsValueAtTop(t *testing.T) {
 	// Arrange
 	stack := NewStack[int]()
@@ -92,6 +113,209 @@ func TestStack_GetReturnsNilOnOutOfBoundsIndex(t *testing.T) {
 	nilAssert(t, got)
 }
 
+func TestStack_ContainsReturnsTrueIfValueExists(t *testing.T) {
+	// Arrange
+	stack := NewStack[int]()
+	stack.Push(1)
+	stack.Push(2)
+	// Act
+	got := Contains(stack, 1)
+	// Assert
+	simpleAssert(t, got, true)
+}
+
+func TestStack_ContainsReturnsFalseOnEmptyStack(t *testing.T) {
+	// Arrange
+	stack := NewStack[int]()
+	// Act
+	got := Contains(stack, 1)
+	// Assert
+	simpleAssert(t, got, false)
+}
+
+func TestStack_HoldsFuncs(t *testing.T) {
+	// Arrange
+	stack := NewStack[func() int]()
+	stack.Push(func() int { return 1 })
+	stack.Push(func() int { return 2 })
+	// Act
+	found := stack.ContainsFunc(func(fn func() int) bool { return fn() == 1 })
+	got := (*stack.Pop())()
+	// Assert
+	simpleAssert(t, found, true)
+	simpleAssert(t, got, 2)
+}
+
+func TestStack_LenAndIsEmpty(t *testing.T) {
+	// Arrange
+	stack := NewStack[int]()
+	empty := stack.IsEmpty()
+	// Act
+	stack.Push(1)
+	stack.Push(2)
+	// Assert
+	simpleAssert(t, empty, true)
+	simpleAssert(t, stack.IsEmpty(), false)
+	simpleAssert(t, stack.Len(), 2)
+}
+
+func TestStack_Clear(t *testing.T) {
+	// Arrange
+	stack := NewStack[int]()
+	stack.Push(1)
+	stack.Push(2)
+	// Act
+	stack.Clear()
+	// Assert
+	simpleAssert(t, stack.Len(), 0)
+	nilAssert(t, stack.Peek())
+}
+
+func TestStack_ToSliceAndString(t *testing.T) {
+	// Arrange
+	stack := NewStack[int]()
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	// Act
+	values := stack.ToSlice()
+	values[0] = 100
+	// Assert
+	simpleAssert(t, len(values), 3)
+	simpleAssert(t, values[2], 1)
+	simpleAssert(t, *stack.Peek(), 3)
+	simpleAssert(t, stack.String(), "[3 2 1]")
+}
+
+func TestStack_PopN(t *testing.T) {
+	// Arrange
+	stack := NewStack[int]()
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	// Act
+	top := stack.PopN(2)
+	rest := stack.PopN(5)
+	// Assert
+	simpleAssert(t, len(top), 2)
+	simpleAssert(t, top[0], 3)
+	simpleAssert(t, top[1], 2)
+	simpleAssert(t, len(rest), 1)
+	simpleAssert(t, rest[0], 1)
+	simpleAssert(t, stack.IsEmpty(), true)
+}
+
+func TestStack_PopNPanicsOnNegativeN(t *testing.T) {
+	// Arrange
+	stack := NewStack[int]()
+	defer func() {
+		// Assert
+		if r := recover(); r == nil {
+			t.Errorf("Expected a panic")
+		}
+	}()
+	// Act
+	stack.PopN(-1)
+}
+
+func TestStack_PushAll(t *testing.T) {
+	// Arrange
+	stack := NewStack[int]()
+	stack.Push(1)
+	// Act
+	stack.PushAll(2, 3, 4)
+	// Assert
+	simpleAssert(t, stack.String(), "[4 3 2 1]")
+}
+
+func TestStack_ReserveAvoidsGrowing(t *testing.T) {
+	// Arrange
+	stack := NewStack[int]()
+	stack.Push(0)
+	// Act
+	stack.Reserve(100)
+	before := &stack.items[0]
+	for i := 1; i <= 100; i++ {
+		stack.Push(i)
+	}
+	// Assert
+	simpleAssert(t, &stack.items[0], before)
+	simpleAssert(t, stack.Len(), 101)
+}
+
+func TestStack_ReserveKeepsCapacityWhenPopping(t *testing.T) {
+	// Arrange
+	stack := NewStack[int]()
+	stack.Reserve(100)
+	stack.PushAll(1, 2, 3)
+	// Act
+	stack.PopN(3)
+	// Assert
+	simpleAssert(t, cap(stack.items) >= 100, true)
+}
+
+func TestStack_ShrinksWhenMostlyEmpty(t *testing.T) {
+	// Arrange
+	stack := NewStack[int]()
+	for i := 0; i < 1000; i++ {
+		stack.Push(i)
+	}
+	grown := cap(stack.items)
+	// Act
+	for i := 0; i < 990; i++ {
+		stack.TryPop()
+	}
+	// Assert
+	simpleAssert(t, cap(stack.items) < grown/4, true)
+	simpleAssert(t, stack.String(), "[9 8 7 6 5 4 3 2 1 0]")
+}
+
+func TestStack_PopNShrinksAllTheWay(t *testing.T) {
+	// Arrange
+	stack := NewStack[int]()
+	for i := 0; i < 1024; i++ {
+		stack.Push(i)
+	}
+	// Act
+	stack.PopN(1020)
+	// Assert
+	simpleAssert(t, cap(stack.items) < 2*minCapacity, true)
+	simpleAssert(t, stack.String(), "[3 2 1 0]")
+}
+
+func TestStack_ShrinkKeepsReservation(t *testing.T) {
+	// Arrange
+	stack := NewStack[int]()
+	stack.Reserve(64)
+	for i := 0; i < 1024; i++ {
+		stack.Push(i)
+	}
+	// Act
+	stack.PopN(1024)
+	// Assert
+	simpleAssert(t, cap(stack.items) >= 64, true)
+	simpleAssert(t, cap(stack.items) < 128, true)
+}
+
+func TestStack_PopClearsSlot(t *testing.T) {
+	// Arrange
+	stack := NewStack[*int]()
+	value := 1
+	stack.Push(&value)
+	stack.Push(&value)
+	// Act
+	stack.TryPop()
+	// Assert
+	simpleAssert(t, stack.items[:2][1], nil)
+}
+
+func okAssert[T comparable](t *testing.T, got T, ok bool, want T) {
+	if !ok {
+		t.Errorf("Expected ok to be true")
+	}
+	simpleAssert(t, got, want)
+}
+
 func nilAssert[T any](t *testing.T, got *T) {
 	if got != nil {
 		t.Errorf("Got %v, wanted nil", got)
@@ -103,3 +327,16 @@ func simpleAssert[T comparable](t *testing.T, got, want T) {
 		t.Errorf("Got %v, wanted %v", got, want)
 	}
 }
+
+func BenchmarkStack_PushPop(b *testing.B) {
+	b.ReportAllocs()
+	stack := NewStack[int]()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < 1000; j++ {
+			stack.Push(j)
+		}
+		for j := 0; j < 1000; j++ {
+			stack.TryPop()
+		}
+	}
+}
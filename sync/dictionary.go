@@ -0,0 +1,100 @@
+package sync
+
+import (
+	"sync"
+
+	"github.com/robertjshirts/data-structures/dictionary"
+	"github.com/robertjshirts/data-structures/kvp"
+)
+
+// Dictionary is a dictionary.Dictionary guarded by a read-write lock.
+// Lookups are linear scans, so they take a shared lock and many can run at once.
+type Dictionary[K kvp.KeyTypes, V any] struct {
+	mu   sync.RWMutex
+	dict dictionary.Dictionary[K, V]
+}
+
+// NewDict creates an empty dictionary
+//
+// Time complexity: O(1) because we're just instantiating the struct
+func NewDict[K kvp.KeyTypes, V any]() *Dictionary[K, V] {
+	return &Dictionary[K, V]{}
+}
+
+// Add sets the value for a key, replacing the old value if the key is already there
+//
+// Time complexity: O(n) because it scans for the key first
+func (d *Dictionary[K, V]) Add(key K, value V) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.dict.Add(key, value)
+}
+
+// Get returns the value for a key, under a shared lock
+//
+// # Returns the value and true, or the zero value and false if the key isn't there
+//
+// Time complexity: O(n) because it scans for the key
+func (d *Dictionary[K, V]) Get(key K) (V, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if value := d.dict.Get(key); value != nil {
+		return *value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Remove removes a key and its value
+//
+// # Returns true if the key was there, false otherwise
+//
+// Time complexity: O(n) because it scans for the key, then shifts the pairs after it
+func (d *Dictionary[K, V]) Remove(key K) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.dict.Remove(key)
+}
+
+// Len returns the number of keys in the dictionary
+//
+// Time complexity: O(1) because dictionary.Dictionary.Len is
+func (d *Dictionary[K, V]) Len() int {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.dict.Len()
+}
+
+// Each calls fn with each key and value in a snapshot of the dictionary, in the order they were first added.
+// Stops early if fn returns false. The lock isn't held while fn runs, so fn may change the dictionary.
+//
+// Time complexity: O(n) because it copies the pairs, then iterates through the copy
+func (d *Dictionary[K, V]) Each(fn func(key K, value V) bool) {
+	d.mu.RLock()
+	pairs := d.dict.GetKVPs()
+	d.mu.RUnlock()
+
+	for _, pair := range pairs {
+		if !fn(pair.Key(), pair.Value()) {
+			return
+		}
+	}
+}
+
+// Read calls fn with the dictionary under a shared lock. fn must not change the dictionary, keep it after returning, or call back into d.
+//
+// Time complexity: the time complexity of fn
+func (d *Dictionary[K, V]) Read(fn func(dict *dictionary.Dictionary[K, V])) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	fn(&d.dict)
+}
+
+// Write calls fn with the dictionary under an exclusive lock. fn must not keep the dictionary after returning, or call back into d.
+//
+// Time complexity: the time complexity of fn
+func (d *Dictionary[K, V]) Write(fn func(dict *dictionary.Dictionary[K, V])) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	fn(&d.dict)
+}
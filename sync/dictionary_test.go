@@ -0,0 +1,76 @@
+package sync
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestDictionary_AddGetRemove(t *testing.T) {
+	// Arrange
+	dict := NewDict[string, int]()
+	// Act
+	dict.Add("a", 1)
+	dict.Add("b", 2)
+	dict.Add("a", 3)
+	// Assert
+	value, ok := dict.Get("a")
+	simpleAssert(t, ok, true)
+	simpleAssert(t, value, 3)
+	_, ok = dict.Get("c")
+	simpleAssert(t, ok, false)
+	simpleAssert(t, dict.Len(), 2)
+	simpleAssert(t, dict.Remove("a"), true)
+	simpleAssert(t, dict.Remove("a"), false)
+	simpleAssert(t, dict.Len(), 1)
+}
+
+func TestDictionary_EachIsASnapshot(t *testing.T) {
+	// Arrange
+	dict := NewDict[string, int]()
+	dict.Add("a", 1)
+	dict.Add("b", 2)
+	var keys []string
+	// Act
+	dict.Each(func(key string, value int) bool {
+		// Removing from fn doesn't change what Each visits
+		dict.Remove("b")
+		keys = append(keys, key)
+		return true
+	})
+	// Assert
+	sliceAssert(t, keys, []string{"a", "b"})
+	simpleAssert(t, dict.Len(), 1)
+}
+
+func TestDictionary_ConcurrentAddGet(t *testing.T) {
+	// Arrange
+	dict := NewDict[string, int]()
+	const workers, perWorker = 8, 200
+	var wg sync.WaitGroup
+	// Act
+	for w := 0; w < workers; w++ {
+		wg.Add(2)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				dict.Add(fmt.Sprint(w, "-", i), i)
+			}
+		}(w)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				dict.Get(fmt.Sprint(w, "-", i))
+				dict.Each(func(string, int) bool { return false })
+			}
+		}(w)
+	}
+	wg.Wait()
+	// Assert
+	simpleAssert(t, dict.Len(), workers*perWorker)
+	for w := 0; w < workers; w++ {
+		value, ok := dict.Get(fmt.Sprint(w, "-", perWorker-1))
+		simpleAssert(t, ok, true)
+		simpleAssert(t, value, perWorker-1)
+	}
+}
@@ -0,0 +1,184 @@
+package sync
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/robertjshirts/data-structures/graph"
+)
+
+// Graph is a graph.Graph guarded by a read-write lock.
+// It doesn't hand out *graph.Vertex, since a vertex could be changed without the lock, so use Neighbors and Weight to look at edges.
+// Algorithms that take a *graph.Graph, like flow.Dinic or mst.Prim, can run inside Read.
+type Graph struct {
+	mu    sync.RWMutex
+	graph *graph.Graph
+}
+
+// EmptyGraph creates a new empty undirected graph
+//
+// Big-O: O(1) because it just creates the graph
+func EmptyGraph() *Graph {
+	return &Graph{graph: graph.EmptyGraph()}
+}
+
+// EmptyDirectedGraph creates a new empty directed graph
+//
+// Big-O: O(1) because it just creates the graph
+func EmptyDirectedGraph() *Graph {
+	return &Graph{graph: graph.EmptyDirectedGraph()}
+}
+
+// NewGraph creates a new undirected graph from an adjacency list, in the format graph.NewGraph takes
+//
+// Big-O: O(n^2) because graph.NewGraph is O(n^2)
+func NewGraph(adjacencyList []string) *Graph {
+	return &Graph{graph: graph.NewGraph(adjacencyList)}
+}
+
+// NewDirectedGraph creates a new directed graph from an adjacency list, in the format graph.NewGraph takes
+//
+// Big-O: O(n^2) because graph.NewDirectedGraph is O(n^2)
+func NewDirectedGraph(adjacencyList []string) *Graph {
+	return &Graph{graph: graph.NewDirectedGraph(adjacencyList)}
+}
+
+// AddVertex adds a vertex with the provided key. If the key already exists, nothing is done
+//
+// Big-O: O(1) because graph.Graph.AddVertex is O(1)
+func (g *Graph) AddVertex(key string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.graph.AddVertex(key)
+}
+
+// AddConnection adds a connection between the two vertices with the provided keys, or updates its weight if it's already there.
+// If either of the vertices doesn't exist, nothing is done
+//
+// Big-O: O(1) because graph.Graph.AddConnection is O(1)
+func (g *Graph) AddConnection(key1, key2 string, weight int) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.graph.AddConnection(key1, key2, weight)
+}
+
+// HasVertex returns true if a vertex with the provided key is in the graph, under a shared lock
+//
+// Big-O: O(1) because it's a map lookup
+func (g *Graph) HasVertex(key string) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.graph.HasVertex(key)
+}
+
+// GetKeys returns a slice of all the keys in the graph, in no particular order
+//
+// Big-O: O(n) because it copies each key
+func (g *Graph) GetKeys() []string {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.graph.GetKeys()
+}
+
+// Directed returns true if the graph is directed
+//
+// Big-O: O(1) because it never changes after the graph is made
+func (g *Graph) Directed() bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.graph.Directed()
+}
+
+// Neighbors returns a copy of the connections of the vertex with the provided key, under a shared lock.
+// Returns nil if the vertex doesn't exist
+//
+// Big-O: O(n) because it copies each connection of the vertex
+func (g *Graph) Neighbors(key string) map[string]int {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.graph.Neighbors(key)
+}
+
+// Degree returns the number of connections of the vertex with the provided key, or 0 if it doesn't exist
+//
+// Big-O: O(1) because it's a map lookup
+func (g *Graph) Degree(key string) int {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.graph.Degree(key)
+}
+
+// Weight returns the weight of the edge between the two vertices, and whether the edge exists
+//
+// Big-O: O(1) because it's two map lookups
+func (g *Graph) Weight(key1, key2 string) (int, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.graph.Weight(key1, key2)
+}
+
+// Order returns the number of vertices in the graph
+//
+// Big-O: O(1) because the graph keeps a count
+func (g *Graph) Order() int {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.graph.Order()
+}
+
+// Size returns the number of edges in the graph
+//
+// Big-O: O(1) because the graph keeps a count
+func (g *Graph) Size() int {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.graph.Size()
+}
+
+// Validate checks the invariants of the graph under a shared lock, see graph.Graph.Validate
+//
+// Big-O: O(n^2) because graph.Graph.Validate is O(n^2)
+func (g *Graph) Validate() error {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.graph.Validate()
+}
+
+// Each calls fn with the key and a copy of the connections of each vertex in a snapshot of the graph, sorted by key.
+// Stops early if fn returns false. The lock isn't held while fn runs, so fn may change the graph.
+//
+// Big-O: O(n^2) because it copies every connection of every vertex
+func (g *Graph) Each(fn func(key string, neighbors map[string]int) bool) {
+	g.mu.RLock()
+	keys := g.graph.GetKeys()
+	slices.Sort(keys)
+	snapshot := make([]map[string]int, len(keys))
+	for i, key := range keys {
+		snapshot[i] = g.graph.Neighbors(key)
+	}
+	g.mu.RUnlock()
+
+	for i, key := range keys {
+		if !fn(key, snapshot[i]) {
+			return
+		}
+	}
+}
+
+// Read calls fn with the graph under a shared lock. fn must not change the graph, keep it after returning, or call back into g.
+//
+// Big-O: the Big-O of fn
+func (g *Graph) Read(fn func(graph *graph.Graph)) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	fn(g.graph)
+}
+
+// Write calls fn with the graph under an exclusive lock. fn must not keep the graph after returning, or call back into g.
+//
+// Big-O: the Big-O of fn
+func (g *Graph) Write(fn func(graph *graph.Graph)) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	fn(g.graph)
+}
@@ -0,0 +1,89 @@
+package sync
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/robertjshirts/data-structures/graph"
+	"github.com/robertjshirts/data-structures/mst"
+)
+
+func TestGraph_AddVertexAndConnection(t *testing.T) {
+	// Arrange
+	g := EmptyGraph()
+	// Act
+	g.AddVertex("a")
+	g.AddVertex("b")
+	g.AddConnection("a", "b", 4)
+	// Assert
+	simpleAssert(t, g.HasVertex("a"), true)
+	simpleAssert(t, g.Order(), 2)
+	simpleAssert(t, g.Size(), 1)
+	simpleAssert(t, g.Degree("b"), 1)
+	weight, ok := g.Weight("b", "a")
+	simpleAssert(t, ok, true)
+	simpleAssert(t, weight, 4)
+	simpleAssert(t, g.Validate(), nil)
+}
+
+func TestGraph_EachIsSortedByKey(t *testing.T) {
+	// Arrange
+	g := NewDirectedGraph([]string{"c,a,b", "a,b:1", "b,c:2"})
+	var keys []string
+	// Act
+	g.Each(func(key string, neighbors map[string]int) bool {
+		keys = append(keys, key)
+		// The neighbors are a copy, so changing them doesn't change the graph
+		neighbors["z"] = 9
+		return true
+	})
+	// Assert
+	sliceAssert(t, keys, []string{"a", "b", "c"})
+	simpleAssert(t, g.Directed(), true)
+	simpleAssert(t, g.Degree("a"), 1)
+}
+
+func TestGraph_ReadRunsAlgorithms(t *testing.T) {
+	// Arrange
+	g := NewGraph([]string{"a,b,c", "a,b:1,c:5", "b,c:2"})
+	weight := 0
+	// Act
+	g.Read(func(inner *graph.Graph) {
+		weight = mst.Weight(mst.Prim(inner))
+	})
+	// Assert
+	simpleAssert(t, weight, 3)
+}
+
+func TestGraph_ConcurrentAddConnection(t *testing.T) {
+	// Arrange
+	g := EmptyGraph()
+	const workers, perWorker = 8, 100
+	for i := 0; i < perWorker; i++ {
+		g.AddVertex(fmt.Sprint(i))
+	}
+	var wg sync.WaitGroup
+	// Act
+	for w := 0; w < workers; w++ {
+		wg.Add(2)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				g.AddConnection(fmt.Sprint(i), fmt.Sprint((i+w+1)%perWorker), w)
+			}
+		}(w)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				g.Neighbors(fmt.Sprint(i))
+				g.Size()
+			}
+			g.Each(func(string, map[string]int) bool { return true })
+		}()
+	}
+	wg.Wait()
+	// Assert
+	simpleAssert(t, g.Validate(), nil)
+	simpleAssert(t, g.Order(), perWorker)
+}
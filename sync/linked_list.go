@@ -0,0 +1,326 @@
+// Package sync wraps the containers in this module with read-write locks, so they're safe to use from many goroutines at once.
+//
+// Each wrapper keeps its container unexported, so nothing can change it without taking the lock.
+// Methods that only read take a shared lock and can run at the same time as each other.
+// Each and ToSlice copy the values under the lock and then let go of it, so iterating sees one consistent snapshot
+// and the callback is free to call back into the wrapper.
+// Read and Write hand the container itself to a callback for anything the wrapper doesn't cover.
+package sync
+
+import (
+	"sync"
+
+	"github.com/robertjshirts/data-structures/linked_list"
+)
+
+// SingleLinkedList is a linked_list.SingleLinkedList guarded by a read-write lock.
+// Methods that would panic on the plain list return an error instead, because an index that was valid when a caller checked it
+// might not be by the time another goroutine is done with the list.
+type SingleLinkedList[T any] struct {
+	mu   sync.RWMutex
+	list linked_list.SingleLinkedList[T]
+}
+
+// NewSingleLinkedList creates an empty list
+//
+// Big-O is O(1) because we're just instantiating the struct
+func NewSingleLinkedList[T any]() *SingleLinkedList[T] {
+	return &SingleLinkedList[T]{list: linked_list.EmptySingleLinkedList[T]()}
+}
+
+// Push adds a value to the start of the list
+//
+// Big-O is O(1) because linked_list.SingleLinkedList.Push is O(1)
+func (s *SingleLinkedList[T]) Push(value T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list.Push(value)
+}
+
+// Add adds a value to the end of the list
+//
+// Big-O is O(1) because linked_list.SingleLinkedList.Add is O(1)
+func (s *SingleLinkedList[T]) Add(value T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list.Add(value)
+}
+
+// Insert adds a value at an index
+//
+// Returns linked_list.ErrIndexOutOfRange if the index is out of range.
+//
+// Big-O is O(n) because it has to walk to the index
+func (s *SingleLinkedList[T]) Insert(value T, index int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.InsertE(value, index)
+}
+
+// Get returns the value at an index, under a shared lock
+//
+// Returns linked_list.ErrIndexOutOfRange if the index is out of range.
+//
+// Big-O is O(n) because it has to walk to the index
+func (s *SingleLinkedList[T]) Get(index int) (T, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.GetE(index)
+}
+
+// Last returns the value at the end of the list, under a shared lock
+//
+// Returns linked_list.ErrEmpty if the list is empty.
+//
+// Big-O is O(1) because the list keeps a pointer to the tail
+func (s *SingleLinkedList[T]) Last() (T, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.LastE()
+}
+
+// Remove removes the first value and returns it
+//
+// Returns linked_list.ErrEmpty if the list is empty.
+//
+// Big-O is O(1) because we're just moving the head
+func (s *SingleLinkedList[T]) Remove() (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.RemoveE()
+}
+
+// RemoveAt removes the value at an index and returns it
+//
+// Returns linked_list.ErrIndexOutOfRange if the index is out of range.
+//
+// Big-O is O(n) because it has to walk to the index
+func (s *SingleLinkedList[T]) RemoveAt(index int) (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.RemoveAtE(index)
+}
+
+// RemoveLast removes the last value and returns it
+//
+// Returns linked_list.ErrEmpty if the list is empty.
+//
+// Big-O is O(n) because a singly linked list has to walk to the node before the tail
+func (s *SingleLinkedList[T]) RemoveLast() (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.RemoveLastE()
+}
+
+// Len returns the number of values in the list
+//
+// Big-O is O(1) because the list keeps a count
+func (s *SingleLinkedList[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.Count
+}
+
+// Clear removes every value from the list
+//
+// Big-O is O(1) because linked_list.SingleLinkedList.Clear is O(1)
+func (s *SingleLinkedList[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list.Clear()
+}
+
+// ToSlice copies the values into a new slice, from head to tail
+//
+// Big-O is O(n) because it copies every value
+func (s *SingleLinkedList[T]) ToSlice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return listToSlice[T](&s.list)
+}
+
+// Each calls fn with the index and value of each node in a snapshot of the list, from head to tail.
+// Stops early if fn returns false. The lock isn't held while fn runs, so fn may change the list.
+//
+// Big-O is O(n) because it copies the list, then iterates through the copy
+func (s *SingleLinkedList[T]) Each(fn func(index int, value T) bool) {
+	each(s.ToSlice(), fn)
+}
+
+// Read calls fn with the list under a shared lock. fn must not change the list, keep it after returning, or call back into s.
+//
+// Big-O is the Big-O of fn
+func (s *SingleLinkedList[T]) Read(fn func(list *linked_list.SingleLinkedList[T])) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	fn(&s.list)
+}
+
+// Write calls fn with the list under an exclusive lock. fn must not keep the list after returning, or call back into s.
+//
+// Big-O is the Big-O of fn
+func (s *SingleLinkedList[T]) Write(fn func(list *linked_list.SingleLinkedList[T])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn(&s.list)
+}
+
+// DoubleLinkedList is a linked_list.DoubleLinkedList guarded by a read-write lock.
+// Methods that would panic on the plain list return an error instead, because an index that was valid when a caller checked it
+// might not be by the time another goroutine is done with the list.
+type DoubleLinkedList[T any] struct {
+	mu   sync.RWMutex
+	list linked_list.DoubleLinkedList[T]
+}
+
+// NewDoubleLinkedList creates an empty list
+//
+// Big-O is O(1) because we're just instantiating the struct
+func NewDoubleLinkedList[T any]() *DoubleLinkedList[T] {
+	return &DoubleLinkedList[T]{list: linked_list.EmptyDoubleLinkedList[T]()}
+}
+
+// Push adds a value to the start of the list
+//
+// Big-O is O(1) because linked_list.DoubleLinkedList.Push is O(1)
+func (s *DoubleLinkedList[T]) Push(value T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list.Push(value)
+}
+
+// Add adds a value to the end of the list
+//
+// Big-O is O(1) because linked_list.DoubleLinkedList.Add is O(1)
+func (s *DoubleLinkedList[T]) Add(value T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list.Add(value)
+}
+
+// Insert adds a value at an index
+//
+// Returns linked_list.ErrIndexOutOfRange if the index is out of range.
+//
+// Big-O is O(n) because it has to walk to the index
+func (s *DoubleLinkedList[T]) Insert(value T, index int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.InsertE(value, index)
+}
+
+// Get returns the value at an index, under a shared lock
+//
+// Returns linked_list.ErrIndexOutOfRange if the index is out of range.
+//
+// Big-O is O(n) because it has to walk to the index
+func (s *DoubleLinkedList[T]) Get(index int) (T, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.GetE(index)
+}
+
+// Remove removes the first value and returns it
+//
+// Returns linked_list.ErrEmpty if the list is empty.
+//
+// Big-O is O(1) because we're just moving the head
+func (s *DoubleLinkedList[T]) Remove() (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.RemoveE()
+}
+
+// RemoveAt removes the value at an index and returns it
+//
+// Returns linked_list.ErrIndexOutOfRange if the index is out of range.
+//
+// Big-O is O(n) because it has to walk to the index
+func (s *DoubleLinkedList[T]) RemoveAt(index int) (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.RemoveAtE(index)
+}
+
+// RemoveLast removes the last value and returns it
+//
+// Returns linked_list.ErrEmpty if the list is empty.
+//
+// Big-O is O(1) because we're just moving the tail
+func (s *DoubleLinkedList[T]) RemoveLast() (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.RemoveLastE()
+}
+
+// Len returns the number of values in the list
+//
+// Big-O is O(1) because the list keeps a count
+func (s *DoubleLinkedList[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.Count
+}
+
+// Clear removes every value from the list
+//
+// Big-O is O(1) because linked_list.DoubleLinkedList.Clear is O(1)
+func (s *DoubleLinkedList[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list.Clear()
+}
+
+// ToSlice copies the values into a new slice, from head to tail
+//
+// Big-O is O(n) because it copies every value
+func (s *DoubleLinkedList[T]) ToSlice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return listToSlice[T](&s.list)
+}
+
+// Each calls fn with the index and value of each node in a snapshot of the list, from head to tail.
+// Stops early if fn returns false. The lock isn't held while fn runs, so fn may change the list.
+//
+// Big-O is O(n) because it copies the list, then iterates through the copy
+func (s *DoubleLinkedList[T]) Each(fn func(index int, value T) bool) {
+	each(s.ToSlice(), fn)
+}
+
+// Read calls fn with the list under a shared lock. fn must not change the list, keep it after returning, or call back into s.
+//
+// Big-O is the Big-O of fn
+func (s *DoubleLinkedList[T]) Read(fn func(list *linked_list.DoubleLinkedList[T])) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	fn(&s.list)
+}
+
+// Write calls fn with the list under an exclusive lock. fn must not keep the list after returning, or call back into s.
+//
+// Big-O is the Big-O of fn
+func (s *DoubleLinkedList[T]) Write(fn func(list *linked_list.DoubleLinkedList[T])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn(&s.list)
+}
+
+// listToSlice copies the values of any list into a new slice, in the order Each visits them
+func listToSlice[T any](it linked_list.Iterable[T]) []T {
+	var values []T
+	it.Each(func(_ int, value T) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
+// each calls fn with the index and value of each item in a snapshot, stopping early if fn returns false
+func each[T any](snapshot []T, fn func(index int, value T) bool) {
+	for i, value := range snapshot {
+		if !fn(i, value) {
+			return
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package sync
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/robertjshirts/data-structures/linked_list"
+)
+
+func TestSingleLinkedList_ReturnsErrorsInsteadOfPanicking(t *testing.T) {
+	// Arrange
+	list := NewSingleLinkedList[int]()
+	// Act
+	_, removeErr := list.Remove()
+	_, getErr := list.Get(3)
+	insertErr := list.Insert(1, 5)
+	// Assert
+	simpleAssert(t, errors.Is(removeErr, linked_list.ErrEmpty), true)
+	simpleAssert(t, errors.Is(getErr, linked_list.ErrIndexOutOfRange), true)
+	simpleAssert(t, errors.Is(insertErr, linked_list.ErrIndexOutOfRange), true)
+}
+
+func TestSingleLinkedList_AddsAndRemoves(t *testing.T) {
+	// Arrange
+	list := NewSingleLinkedList[int]()
+	// Act
+	list.Add(2)
+	list.Push(1)
+	list.Add(4)
+	err := list.Insert(3, 2)
+	// Assert
+	simpleAssert(t, err, nil)
+	sliceAssert(t, list.ToSlice(), []int{1, 2, 3, 4})
+	last, err := list.Last()
+	simpleAssert(t, err, nil)
+	simpleAssert(t, last, 4)
+	removed, _ := list.RemoveAt(1)
+	simpleAssert(t, removed, 2)
+	removed, _ = list.RemoveLast()
+	simpleAssert(t, removed, 4)
+	simpleAssert(t, list.Len(), 2)
+}
+
+func TestDoubleLinkedList_AddsAndRemoves(t *testing.T) {
+	// Arrange
+	list := NewDoubleLinkedList[int]()
+	// Act
+	list.Add(2)
+	list.Push(1)
+	list.Add(3)
+	// Assert
+	got, err := list.Get(1)
+	simpleAssert(t, err, nil)
+	simpleAssert(t, got, 2)
+	removed, _ := list.Remove()
+	simpleAssert(t, removed, 1)
+	removed, _ = list.RemoveLast()
+	simpleAssert(t, removed, 3)
+	sliceAssert(t, list.ToSlice(), []int{2})
+	list.Clear()
+	simpleAssert(t, list.Len(), 0)
+}
+
+func TestDoubleLinkedList_EachCanChangeTheList(t *testing.T) {
+	// Arrange
+	list := NewDoubleLinkedList[int]()
+	list.Add(1)
+	list.Add(2)
+	visited := 0
+	// Act
+	// Each iterates a snapshot without the lock, so adding from fn doesn't deadlock or change what's visited
+	list.Each(func(_ int, value int) bool {
+		list.Add(value * 10)
+		visited++
+		return true
+	})
+	// Assert
+	simpleAssert(t, visited, 2)
+	sliceAssert(t, list.ToSlice(), []int{1, 2, 10, 20})
+}
+
+func TestSingleLinkedList_ReadAndWrite(t *testing.T) {
+	// Arrange
+	list := NewSingleLinkedList[int]()
+	list.Add(1)
+	list.Add(2)
+	// Act
+	list.Write(func(inner *linked_list.SingleLinkedList[int]) {
+		inner.Reverse()
+	})
+	// Assert
+	list.Read(func(inner *linked_list.SingleLinkedList[int]) {
+		simpleAssert(t, inner.Validate(), nil)
+		simpleAssert(t, inner.Head.Value, 2)
+	})
+}
+
+func TestLinkedLists_ConcurrentAddRemove(t *testing.T) {
+	// Arrange
+	single := NewSingleLinkedList[int]()
+	double := NewDoubleLinkedList[int]()
+	const workers, perWorker = 8, 500
+	var wg sync.WaitGroup
+	// Act
+	for w := 0; w < workers; w++ {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				single.Add(i)
+				double.Push(i)
+				single.Get(0)
+				double.Get(0)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				single.Remove()
+				double.RemoveLast()
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWorker/10; i++ {
+				single.Each(func(int, int) bool { return true })
+				double.ToSlice()
+				single.Len()
+			}
+		}()
+	}
+	wg.Wait()
+	// Assert
+	single.Read(func(inner *linked_list.SingleLinkedList[int]) {
+		simpleAssert(t, inner.Validate(), nil)
+	})
+	double.Read(func(inner *linked_list.DoubleLinkedList[int]) {
+		simpleAssert(t, inner.Validate(), nil)
+	})
+	simpleAssert(t, len(single.ToSlice()), single.Len())
+	simpleAssert(t, len(double.ToSlice()), double.Len())
+}
+
+func simpleAssert[T comparable](t *testing.T, got, want T) {
+	t.Helper()
+	if got != want {
+		t.Errorf("Got %v, wanted %v", got, want)
+	}
+}
+
+func sliceAssert[T comparable](t *testing.T, got, want []T) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("Got %v, wanted %v", got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Fatalf("Got %v, wanted %v", got, want)
+		}
+	}
+}
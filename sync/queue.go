@@ -0,0 +1,145 @@
+package sync
+
+import (
+	"sync"
+
+	"github.com/robertjshirts/data-structures/queue"
+)
+
+// Queue is a queue.Queue guarded by a read-write lock. It never blocks waiting for items,
+// see queue.BlockingQueue for a bounded queue that does, or queue.LockFreeQueue for one that never takes a lock.
+type Queue[T any] struct {
+	mu    sync.RWMutex
+	queue queue.Queue[T]
+}
+
+// NewQueue creates an empty queue
+//
+// Time complexity: O(1) because we're just instantiating the struct
+func NewQueue[T any]() *Queue[T] {
+	return &Queue[T]{}
+}
+
+// Enqueue adds a value to the end of the queue
+//
+// Time complexity: O(1) amortized, because queue.Queue.Enqueue is
+func (q *Queue[T]) Enqueue(value T) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.queue.Enqueue(value)
+}
+
+// TryDequeue removes the first value from the queue and returns it
+//
+// # Returns the first value and true, or the zero value and false if the queue is empty
+//
+// Time complexity: O(1) amortized, because queue.Queue.TryDequeue is
+func (q *Queue[T]) TryDequeue() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.queue.TryDequeue()
+}
+
+// TryPeek returns the first value without removing it, under a shared lock
+//
+// # Returns the first value and true, or the zero value and false if the queue is empty
+//
+// Time complexity: O(1) because it's the front of the ring buffer
+func (q *Queue[T]) TryPeek() (T, bool) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return q.queue.TryPeek()
+}
+
+// Get returns the value at an index, where 0 is the front of the queue, under a shared lock
+//
+// # Returns a pointer to a copy of the value, or nil if the index is out of range
+//
+// Time complexity: O(1) because the ring buffer can index straight to it
+func (q *Queue[T]) Get(index int) *T {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return q.queue.Get(index)
+}
+
+// ContainsFunc returns true if any value in the queue matches, under a shared lock. match must not call back into q.
+//
+// Time complexity: O(n) because it may check every value
+func (q *Queue[T]) ContainsFunc(match func(T) bool) bool {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return q.queue.ContainsFunc(match)
+}
+
+// DequeueN removes up to n values from the front of the queue all under one lock, and returns them front first
+//
+// # Panics if n is negative
+//
+// Time complexity: O(n) because each dequeue is O(1) amortized
+func (q *Queue[T]) DequeueN(n int) []T {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.queue.DequeueN(n)
+}
+
+// Len returns the number of values in the queue
+//
+// Time complexity: O(1) because the ring buffer keeps a count
+func (q *Queue[T]) Len() int {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return q.queue.Len()
+}
+
+// IsEmpty returns true if the queue has no values
+//
+// Time complexity: O(1) because the ring buffer keeps a count
+func (q *Queue[T]) IsEmpty() bool {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return q.queue.IsEmpty()
+}
+
+// Clear removes every value from the queue
+//
+// Time complexity: O(1) because queue.Queue.Clear just drops the buffer
+func (q *Queue[T]) Clear() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.queue.Clear()
+}
+
+// ToSlice copies the values into a new slice, front first
+//
+// Time complexity: O(n) because it copies every value
+func (q *Queue[T]) ToSlice() []T {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return q.queue.ToSlice()
+}
+
+// Each calls fn with the index and value of each item in a snapshot of the queue, front first.
+// Stops early if fn returns false. The lock isn't held while fn runs, so fn may change the queue.
+//
+// Time complexity: O(n) because it copies the queue, then iterates through the copy
+func (q *Queue[T]) Each(fn func(index int, value T) bool) {
+	each(q.ToSlice(), fn)
+}
+
+// Read calls fn with the queue under a shared lock. fn must not change the queue, keep it after returning, or call back into q.
+//
+// Time complexity: the time complexity of fn
+func (q *Queue[T]) Read(fn func(queue *queue.Queue[T])) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	fn(&q.queue)
+}
+
+// Write calls fn with the queue under an exclusive lock. fn must not keep the queue after returning, or call back into q.
+//
+// Time complexity: the time complexity of fn
+func (q *Queue[T]) Write(fn func(queue *queue.Queue[T])) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	fn(&q.queue)
+}
@@ -0,0 +1,75 @@
+package sync
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestQueue_EnqueueDequeue(t *testing.T) {
+	// Arrange
+	queue := NewQueue[int]()
+	// Act
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	queue.Enqueue(3)
+	// Assert
+	front, ok := queue.TryPeek()
+	simpleAssert(t, ok, true)
+	simpleAssert(t, front, 1)
+	simpleAssert(t, *queue.Get(2), 3)
+	simpleAssert(t, queue.ContainsFunc(func(value int) bool { return value == 2 }), true)
+	sliceAssert(t, queue.DequeueN(2), []int{1, 2})
+	sliceAssert(t, queue.ToSlice(), []int{3})
+	queue.Clear()
+	simpleAssert(t, queue.IsEmpty(), true)
+}
+
+func TestQueue_ConcurrentEnqueueDequeue(t *testing.T) {
+	// Arrange
+	queue := NewQueue[int]()
+	const producers, perProducer = 8, 1000
+	var wg sync.WaitGroup
+	dequeued := make([][]int, producers)
+	// Act
+	for p := 0; p < producers; p++ {
+		wg.Add(2)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				queue.Enqueue(p*perProducer + i)
+			}
+		}(p)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				if value, ok := queue.TryDequeue(); ok {
+					dequeued[p] = append(dequeued[p], value)
+				}
+				queue.TryPeek()
+			}
+		}(p)
+	}
+	wg.Wait()
+	dequeued[0] = append(dequeued[0], queue.DequeueN(queue.Len())...)
+	// Assert
+	// Every value should come out exactly once, and each producer's values should come out in the order they went in
+	seen := make([]bool, producers*perProducer)
+	count := 0
+	for _, values := range dequeued {
+		last := make([]int, producers)
+		for i := range last {
+			last[i] = -1
+		}
+		for _, value := range values {
+			simpleAssert(t, seen[value], false)
+			seen[value] = true
+			count++
+			producer := value / perProducer
+			if value < last[producer] {
+				t.Fatalf("Got %d after %d from the same producer", value, last[producer])
+			}
+			last[producer] = value
+		}
+	}
+	simpleAssert(t, count, producers*perProducer)
+}
@@ -0,0 +1,154 @@
+package sync
+
+import (
+	"sync"
+
+	"github.com/robertjshirts/data-structures/stack"
+)
+
+// Stack is a stack.Stack guarded by a read-write lock.
+// See stack.LockFreeStack for a stack that only needs Push, TryPop and TryPeek and never blocks.
+type Stack[T any] struct {
+	mu    sync.RWMutex
+	stack stack.Stack[T]
+}
+
+// NewStack creates an empty stack
+//
+// Time complexity: O(1) because we're just instantiating the struct
+func NewStack[T any]() *Stack[T] {
+	return &Stack[T]{}
+}
+
+// Push adds a value to the top of the stack
+//
+// Time complexity: O(1) amortized, because stack.Stack.Push is
+func (s *Stack[T]) Push(value T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stack.Push(value)
+}
+
+// PushAll adds values to the top of the stack in order, all under one lock, so no other push lands in between them
+//
+// Time complexity: O(k) amortized, where k is the number of values
+func (s *Stack[T]) PushAll(values ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stack.PushAll(values...)
+}
+
+// TryPop removes the top value from the stack and returns it
+//
+// # Returns the top value and true, or the zero value and false if the stack is empty
+//
+// Time complexity: O(1) amortized, because stack.Stack.TryPop is
+func (s *Stack[T]) TryPop() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.TryPop()
+}
+
+// TryPeek returns the top value without removing it, under a shared lock
+//
+// # Returns the top value and true, or the zero value and false if the stack is empty
+//
+// Time complexity: O(1) because it's the last value in the slice
+func (s *Stack[T]) TryPeek() (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stack.TryPeek()
+}
+
+// Get returns the value at an index counting down from the top, which is index 0, under a shared lock
+//
+// # Returns a pointer to a copy of the value, or nil if the index is out of range
+//
+// Time complexity: O(1) because the stack is backed by a slice
+func (s *Stack[T]) Get(index int) *T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stack.Get(index)
+}
+
+// ContainsFunc returns true if any value on the stack matches, under a shared lock. match must not call back into s.
+//
+// Time complexity: O(n) because it may check every value
+func (s *Stack[T]) ContainsFunc(match func(T) bool) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stack.ContainsFunc(match)
+}
+
+// PopN removes up to n values from the top of the stack all under one lock, and returns them top first
+//
+// # Panics if n is negative
+//
+// Time complexity: O(n) because it copies each popped value
+func (s *Stack[T]) PopN(n int) []T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.PopN(n)
+}
+
+// Len returns the number of values on the stack
+//
+// Time complexity: O(1) because it's the length of the slice
+func (s *Stack[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stack.Len()
+}
+
+// IsEmpty returns true if the stack has no values
+//
+// Time complexity: O(1) because it's the length of the slice
+func (s *Stack[T]) IsEmpty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stack.IsEmpty()
+}
+
+// Clear removes every value from the stack
+//
+// Time complexity: O(1) because stack.Stack.Clear just drops the slice
+func (s *Stack[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stack.Clear()
+}
+
+// ToSlice copies the values into a new slice, top first
+//
+// Time complexity: O(n) because it copies every value
+func (s *Stack[T]) ToSlice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stack.ToSlice()
+}
+
+// Each calls fn with the index and value of each item in a snapshot of the stack, top first.
+// Stops early if fn returns false. The lock isn't held while fn runs, so fn may change the stack.
+//
+// Time complexity: O(n) because it copies the stack, then iterates through the copy
+func (s *Stack[T]) Each(fn func(index int, value T) bool) {
+	each(s.ToSlice(), fn)
+}
+
+// Read calls fn with the stack under a shared lock. fn must not change the stack, keep it after returning, or call back into s.
+//
+// Time complexity: the time complexity of fn
+func (s *Stack[T]) Read(fn func(stack *stack.Stack[T])) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	fn(&s.stack)
+}
+
+// Write calls fn with the stack under an exclusive lock. fn must not keep the stack after returning, or call back into s.
+//
+// Time complexity: the time complexity of fn
+func (s *Stack[T]) Write(fn func(stack *stack.Stack[T])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn(&s.stack)
+}
@@ -0,0 +1,68 @@
+package sync
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestStack_PushPop(t *testing.T) {
+	// Arrange
+	stack := NewStack[int]()
+	// Act
+	stack.Push(1)
+	stack.PushAll(2, 3)
+	// Assert
+	top, ok := stack.TryPeek()
+	simpleAssert(t, ok, true)
+	simpleAssert(t, top, 3)
+	sliceAssert(t, stack.ToSlice(), []int{3, 2, 1})
+	sliceAssert(t, stack.PopN(2), []int{3, 2})
+	popped, ok := stack.TryPop()
+	simpleAssert(t, ok, true)
+	simpleAssert(t, popped, 1)
+	_, ok = stack.TryPop()
+	simpleAssert(t, ok, false)
+	simpleAssert(t, stack.IsEmpty(), true)
+}
+
+func TestStack_ConcurrentPushPop(t *testing.T) {
+	// Arrange
+	stack := NewStack[int]()
+	const workers, perWorker = 8, 1000
+	var wg sync.WaitGroup
+	popped := make([][]int, workers)
+	// Act
+	for w := 0; w < workers; w++ {
+		wg.Add(2)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				stack.Push(w*perWorker + i)
+				if value, ok := stack.TryPop(); ok {
+					popped[w] = append(popped[w], value)
+				}
+			}
+		}(w)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWorker/10; i++ {
+				stack.Each(func(int, int) bool { return true })
+				stack.TryPeek()
+			}
+		}()
+	}
+	wg.Wait()
+	popped[0] = append(popped[0], stack.PopN(stack.Len())...)
+	// Assert
+	// Every pushed value should come out exactly once
+	seen := make([]bool, workers*perWorker)
+	count := 0
+	for _, values := range popped {
+		for _, value := range values {
+			simpleAssert(t, seen[value], false)
+			seen[value] = true
+			count++
+		}
+	}
+	simpleAssert(t, count, workers*perWorker)
+}
@@ -0,0 +1,227 @@
+package sync
+
+import (
+	"sync"
+
+	"github.com/robertjshirts/data-structures/avl_tree"
+	"github.com/robertjshirts/data-structures/binary_tree"
+)
+
+// ordered matches the value types the tree packages accept
+type ordered interface {
+	~int | ~string | ~float64
+}
+
+// BinaryTree is a binary_tree.BinaryTree guarded by a read-write lock.
+// Contains and Height take a shared lock, so lookups don't block each other.
+type BinaryTree[T ordered] struct {
+	mu   sync.RWMutex
+	tree binary_tree.BinaryTree[T]
+}
+
+// NewBinaryTree creates an empty tree
+//
+// Time complexity: O(1) because we're just instantiating the struct
+func NewBinaryTree[T ordered]() *BinaryTree[T] {
+	return &BinaryTree[T]{}
+}
+
+// Insert adds a value to the tree. Duplicates are kept
+//
+// Time complexity: O(log n), or O(n) if the tree is unbalanced
+func (bt *BinaryTree[T]) Insert(value T) {
+	bt.mu.Lock()
+	defer bt.mu.Unlock()
+	bt.tree.Insert(value)
+}
+
+// Remove removes one copy of a value from the tree
+//
+// # Returns true if the value was in the tree, false otherwise
+//
+// Time complexity: O(log n), or O(n) if the tree is unbalanced
+func (bt *BinaryTree[T]) Remove(value T) bool {
+	bt.mu.Lock()
+	defer bt.mu.Unlock()
+	return bt.tree.Remove(value)
+}
+
+// Contains returns true if the value is in the tree, under a shared lock
+//
+// Time complexity: O(log n), or O(n) if the tree is unbalanced
+func (bt *BinaryTree[T]) Contains(value T) bool {
+	bt.mu.RLock()
+	defer bt.mu.RUnlock()
+	return bt.tree.Contains(value)
+}
+
+// Height returns the height of the tree, under a shared lock
+//
+// Time complexity: O(n) because binary_tree.BinaryTree.Height visits every node
+func (bt *BinaryTree[T]) Height() int {
+	bt.mu.RLock()
+	defer bt.mu.RUnlock()
+	return bt.tree.Height()
+}
+
+// Len returns the number of values in the tree
+//
+// Time complexity: O(1) because the tree keeps a count
+func (bt *BinaryTree[T]) Len() int {
+	bt.mu.RLock()
+	defer bt.mu.RUnlock()
+	return bt.tree.Count
+}
+
+// Clear removes every value from the tree
+//
+// Time complexity: O(1) because we just drop the root
+func (bt *BinaryTree[T]) Clear() {
+	bt.mu.Lock()
+	defer bt.mu.Unlock()
+	bt.tree.Clear()
+}
+
+// ToSlice copies the values into a new slice, smallest first
+//
+// Time complexity: O(n) because it visits every node in order
+func (bt *BinaryTree[T]) ToSlice() []T {
+	bt.mu.RLock()
+	defer bt.mu.RUnlock()
+	return bt.tree.ToArray()
+}
+
+// Each calls fn with the index and value of each node in a snapshot of the tree, smallest first.
+// Stops early if fn returns false. The lock isn't held while fn runs, so fn may change the tree.
+//
+// Time complexity: O(n) because it copies the tree, then iterates through the copy
+func (bt *BinaryTree[T]) Each(fn func(index int, value T) bool) {
+	each(bt.ToSlice(), fn)
+}
+
+// Read calls fn with the tree under a shared lock. fn must not change the tree, keep it after returning, or call back into bt.
+//
+// Time complexity: the time complexity of fn
+func (bt *BinaryTree[T]) Read(fn func(tree *binary_tree.BinaryTree[T])) {
+	bt.mu.RLock()
+	defer bt.mu.RUnlock()
+	fn(&bt.tree)
+}
+
+// Write calls fn with the tree under an exclusive lock. fn must not keep the tree after returning, or call back into bt.
+//
+// Time complexity: the time complexity of fn
+func (bt *BinaryTree[T]) Write(fn func(tree *binary_tree.BinaryTree[T])) {
+	bt.mu.Lock()
+	defer bt.mu.Unlock()
+	fn(&bt.tree)
+}
+
+// AVLTree is an avl_tree.AVLTree guarded by a read-write lock.
+// Contains and Height take a shared lock, so lookups don't block each other.
+type AVLTree[T ordered] struct {
+	mu   sync.RWMutex
+	tree avl_tree.AVLTree[T]
+}
+
+// NewAVLTree creates a tree holding values
+//
+// Time complexity: O(k log k), where k is the number of values, because each insert is O(log k)
+func NewAVLTree[T ordered](values ...T) *AVLTree[T] {
+	avl := &AVLTree[T]{}
+	for _, value := range values {
+		avl.tree.Insert(value)
+	}
+	return avl
+}
+
+// Insert adds a value to the tree and rebalances it. Duplicates are kept
+//
+// Time complexity: O(log n) because the tree stays balanced
+func (avl *AVLTree[T]) Insert(value T) {
+	avl.mu.Lock()
+	defer avl.mu.Unlock()
+	avl.tree.Insert(value)
+}
+
+// Remove removes one copy of a value from the tree and rebalances it
+//
+// # Returns true if the value was in the tree, false otherwise
+//
+// Time complexity: O(log n) because the tree stays balanced
+func (avl *AVLTree[T]) Remove(value T) bool {
+	avl.mu.Lock()
+	defer avl.mu.Unlock()
+	return avl.tree.Remove(value)
+}
+
+// Contains returns true if the value is in the tree, under a shared lock
+//
+// Time complexity: O(log n) because the tree stays balanced
+func (avl *AVLTree[T]) Contains(value T) bool {
+	avl.mu.RLock()
+	defer avl.mu.RUnlock()
+	return avl.tree.Contains(value)
+}
+
+// Height returns the height of the tree, under a shared lock
+//
+// Time complexity: O(1) because the root keeps its height
+func (avl *AVLTree[T]) Height() int {
+	avl.mu.RLock()
+	defer avl.mu.RUnlock()
+	return avl.tree.Height()
+}
+
+// Len returns the number of values in the tree
+//
+// Time complexity: O(1) because the tree keeps a count
+func (avl *AVLTree[T]) Len() int {
+	avl.mu.RLock()
+	defer avl.mu.RUnlock()
+	return avl.tree.Count
+}
+
+// Clear removes every value from the tree
+//
+// Time complexity: O(1) because we just drop the root
+func (avl *AVLTree[T]) Clear() {
+	avl.mu.Lock()
+	defer avl.mu.Unlock()
+	avl.tree.Clear()
+}
+
+// ToSlice copies the values into a new slice, smallest first
+//
+// Time complexity: O(n) because it visits every node in order
+func (avl *AVLTree[T]) ToSlice() []T {
+	avl.mu.RLock()
+	defer avl.mu.RUnlock()
+	return avl.tree.ToSortedArray()
+}
+
+// Each calls fn with the index and value of each node in a snapshot of the tree, smallest first.
+// Stops early if fn returns false. The lock isn't held while fn runs, so fn may change the tree.
+//
+// Time complexity: O(n) because it copies the tree, then iterates through the copy
+func (avl *AVLTree[T]) Each(fn func(index int, value T) bool) {
+	each(avl.ToSlice(), fn)
+}
+
+// Read calls fn with the tree under a shared lock. fn must not change the tree, keep it after returning, or call back into avl.
+//
+// Time complexity: the time complexity of fn
+func (avl *AVLTree[T]) Read(fn func(tree *avl_tree.AVLTree[T])) {
+	avl.mu.RLock()
+	defer avl.mu.RUnlock()
+	fn(&avl.tree)
+}
+
+// Write calls fn with the tree under an exclusive lock. fn must not keep the tree after returning, or call back into avl.
+//
+// Time complexity: the time complexity of fn
+func (avl *AVLTree[T]) Write(fn func(tree *avl_tree.AVLTree[T])) {
+	avl.mu.Lock()
+	defer avl.mu.Unlock()
+	fn(&avl.tree)
+}
@@ -0,0 +1,77 @@
+package sync
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestBinaryTree_InsertRemove(t *testing.T) {
+	// Arrange
+	tree := NewBinaryTree[int]()
+	// Act
+	tree.Insert(2)
+	tree.Insert(1)
+	tree.Insert(3)
+	// Assert
+	simpleAssert(t, tree.Contains(1), true)
+	simpleAssert(t, tree.Height(), 2)
+	simpleAssert(t, tree.Remove(1), true)
+	simpleAssert(t, tree.Remove(1), false)
+	simpleAssert(t, tree.Len(), 2)
+	sliceAssert(t, tree.ToSlice(), []int{2, 3})
+}
+
+func TestAVLTree_ToSliceIsSorted(t *testing.T) {
+	// Arrange
+	tree := NewAVLTree(5, 3, 8, 1, 4)
+	var values []int
+	// Act
+	tree.Each(func(_ int, value int) bool {
+		values = append(values, value)
+		return true
+	})
+	// Assert
+	sliceAssert(t, values, []int{1, 3, 4, 5, 8})
+	simpleAssert(t, tree.Remove(9), false)
+	simpleAssert(t, tree.Len(), 5)
+}
+
+func TestTrees_ConcurrentInsertContains(t *testing.T) {
+	// Arrange
+	binary := NewBinaryTree[int]()
+	avl := NewAVLTree[int]()
+	const workers, perWorker = 8, 300
+	var wg sync.WaitGroup
+	// Act
+	for w := 0; w < workers; w++ {
+		wg.Add(2)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				// Interleave workers so the binary tree doesn't degrade into a list
+				value := i*workers + w
+				binary.Insert(value)
+				avl.Insert(value)
+				if i%3 == 0 {
+					binary.Remove(value)
+					avl.Remove(value)
+				}
+			}
+		}(w)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				binary.Contains(i*workers + w)
+				avl.Contains(i*workers + w)
+				avl.Height()
+			}
+		}(w)
+	}
+	wg.Wait()
+	// Assert
+	expected := workers * (perWorker - (perWorker+2)/3)
+	simpleAssert(t, binary.Len(), expected)
+	simpleAssert(t, avl.Len(), expected)
+	simpleAssert(t, len(binary.ToSlice()), expected)
+	simpleAssert(t, len(avl.ToSlice()), expected)
+}